@@ -0,0 +1,286 @@
+package audit
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/logging"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// Tables that are not audited: the log itself, and bookkeeping that is not a change made
+// by a caller
+var skipped = map[string]bool{
+	"audit_entries":     true,
+	"sessions":          true,
+	"schema_migrations": true,
+}
+
+const beforeKey = "audit:before"
+
+var deletedAtType = reflect.TypeOf(gorm.DeletedAt{})
+
+// Register hooks GORM so every create, update and delete of an audited table writes an
+// AuditEntry in the same transaction, naming the caller and request from the statement's
+// context. Like cache invalidation it runs on the statement, so new mutations are
+// covered without further wiring.
+func Register(database *gorm.DB) error {
+	callbacks := database.Callback()
+	if err := callbacks.Create().After("gorm:create").Register("audit:record", recordCreate); err != nil {
+		return err
+	}
+	if err := callbacks.Update().Before("gorm:update").Register("audit:snapshot", snapshot); err != nil {
+		return err
+	}
+	if err := callbacks.Update().After("gorm:update").Register("audit:record", recordUpdate); err != nil {
+		return err
+	}
+	if err := callbacks.Delete().Before("gorm:delete").Register("audit:snapshot", snapshot); err != nil {
+		return err
+	}
+	if err := callbacks.Delete().After("gorm:delete").Register("audit:record", recordDelete); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Diff keeps only the columns whose values differ between two row snapshots. Times are
+// the same at the same instant, whatever their location.
+func Diff(before, after map[string]interface{}) (map[string]interface{}, map[string]interface{}) {
+	changedBefore := map[string]interface{}{}
+	changedAfter := map[string]interface{}{}
+	for column, value := range after {
+		old, ok := before[column]
+		if ok && same(old, value) {
+			continue
+		}
+		changedBefore[column] = old
+		changedAfter[column] = value
+	}
+	return changedBefore, changedAfter
+}
+
+func same(a, b interface{}) bool {
+	a, b = deref(a), deref(b)
+	if x, ok := a.(time.Time); ok {
+		y, ok := b.(time.Time)
+		return ok && x.Equal(y)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// deref reads through a pointer column value; a nil pointer is nil
+func deref(value interface{}) interface{} {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Pointer {
+		return value
+	}
+	if v.IsNil() {
+		return nil
+	}
+	return v.Elem().Interface()
+}
+
+func audited(tx *gorm.DB) bool {
+	return tx.Error == nil && tx.Statement.Schema != nil && !skipped[tx.Statement.Table]
+}
+
+func recordCreate(tx *gorm.DB) {
+	// An upsert whose conflict clause matched nothing created nothing; dry runs never
+	// report rows
+	if !audited(tx) || !tx.DryRun && tx.RowsAffected == 0 {
+		return
+	}
+	var entries []db.AuditEntry
+	for _, row := range rows(tx.Statement.ReflectValue) {
+		values := columns(tx, row)
+		entries = append(entries, entry(tx, db.AuditCreate, row, nil, values))
+	}
+	write(tx, entries)
+}
+
+// snapshot loads the rows an update or delete is about to change, before it runs
+func snapshot(tx *gorm.DB) {
+	if !audited(tx) {
+		return
+	}
+	before, err := load(tx)
+	if err != nil {
+		tx.AddError(fmt.Errorf("failed to snapshot rows for the audit log: %w", err))
+		return
+	}
+	tx.InstanceSet(beforeKey, before)
+}
+
+func recordUpdate(tx *gorm.DB) {
+	before, ok := loaded(tx)
+	if !ok {
+		return
+	}
+	var entries []db.AuditEntry
+	for _, row := range before {
+		after, err := reload(tx, row)
+		if err != nil {
+			tx.AddError(fmt.Errorf("failed to reload row for the audit log: %w", err))
+			return
+		}
+		changedBefore, changedAfter := Diff(columns(tx, row), columns(tx, after))
+		if len(changedAfter) == 0 {
+			continue
+		}
+		entries = append(entries, entry(tx, db.AuditUpdate, row, changedBefore, changedAfter))
+	}
+	write(tx, entries)
+}
+
+func recordDelete(tx *gorm.DB) {
+	before, ok := loaded(tx)
+	if !ok {
+		return
+	}
+	var entries []db.AuditEntry
+	for _, row := range before {
+		entries = append(entries, entry(tx, db.AuditDelete, row, columns(tx, row), nil))
+	}
+	write(tx, entries)
+}
+
+func loaded(tx *gorm.DB) ([]reflect.Value, bool) {
+	if !audited(tx) || tx.RowsAffected == 0 {
+		return nil, false
+	}
+	value, ok := tx.InstanceGet(beforeKey)
+	if !ok {
+		return nil, false
+	}
+	before, _ := value.([]reflect.Value)
+	return before, len(before) > 0
+}
+
+// load finds the rows a statement targets: by the primary key of its model when set,
+// otherwise by its WHERE clause. Statements with neither change nothing GORM allows. Like
+// the statement, it only sees soft-deleted rows when unscoped.
+func load(tx *gorm.DB) ([]reflect.Value, error) {
+	stmt := tx.Statement
+	query := tx.Session(&gorm.Session{NewDB: true, SkipHooks: true}).Table(stmt.Table)
+	if stmt.Unscoped {
+		query = query.Unscoped()
+	}
+
+	if conditions, ok := primaryKey(stmt, stmt.ReflectValue); ok {
+		query = query.Where(conditions)
+	} else if where, ok := stmt.Clauses["WHERE"]; ok {
+		query = query.Clauses(where.Expression)
+	} else {
+		return nil, nil
+	}
+
+	found := reflect.New(reflect.SliceOf(stmt.Schema.ModelType))
+	if err := query.Find(found.Interface()).Error; err != nil {
+		return nil, err
+	}
+	return rows(found.Elem()), nil
+}
+
+// reload reads a row back by its primary key after the statement changed it
+func reload(tx *gorm.DB, row reflect.Value) (reflect.Value, error) {
+	stmt := tx.Statement
+	conditions, _ := primaryKey(stmt, row)
+	after := reflect.New(stmt.Schema.ModelType)
+	err := tx.Session(&gorm.Session{NewDB: true, SkipHooks: true}).Unscoped().Table(stmt.Table).
+		Where(conditions).Take(after.Interface()).Error
+	return after.Elem(), err
+}
+
+// primaryKey builds conditions matching a single struct's primary key, if every part of
+// it is set
+func primaryKey(stmt *gorm.Statement, value reflect.Value) (clause.AndConditions, bool) {
+	value = reflect.Indirect(value)
+	if value.Kind() != reflect.Struct || len(stmt.Schema.PrimaryFields) == 0 {
+		return clause.AndConditions{}, false
+	}
+	var conditions clause.AndConditions
+	for _, field := range stmt.Schema.PrimaryFields {
+		key, zero := field.ValueOf(stmt.Context, value)
+		if zero {
+			return clause.AndConditions{}, false
+		}
+		conditions.Exprs = append(conditions.Exprs, clause.Eq{Column: clause.Column{Table: stmt.Table, Name: field.DBName}, Value: key})
+	}
+	return conditions, true
+}
+
+// rows flattens a statement's model value into its structs
+func rows(value reflect.Value) []reflect.Value {
+	value = reflect.Indirect(value)
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		result := make([]reflect.Value, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			result = append(result, reflect.Indirect(value.Index(i)))
+		}
+		return result
+	case reflect.Struct:
+		return []reflect.Value{value}
+	}
+	return nil
+}
+
+// columns snapshots a row by column name. Fields hidden from JSON, such as password
+// hashes, and update timestamps are left out; deletion timestamps are kept so restores
+// show up as updates.
+func columns(tx *gorm.DB, row reflect.Value) map[string]interface{} {
+	values := map[string]interface{}{}
+	for _, field := range tx.Statement.Schema.Fields {
+		if !recorded(field) {
+			continue
+		}
+		value, _ := field.ValueOf(tx.Statement.Context, row)
+		values[field.DBName] = value
+	}
+	return values
+}
+
+func recorded(field *schema.Field) bool {
+	if field.FieldType == deletedAtType {
+		return true
+	}
+	return field.DBName != "" && field.Tag.Get("json") != "-" && field.AutoUpdateTime == 0
+}
+
+func entry(tx *gorm.DB, operation db.AuditOperation, row reflect.Value, before, after map[string]interface{}) db.AuditEntry {
+	ctx := tx.Statement.Context
+	var keys []string
+	for _, field := range tx.Statement.Schema.PrimaryFields {
+		key, _ := field.ValueOf(ctx, row)
+		keys = append(keys, fmt.Sprint(key))
+	}
+
+	result := db.AuditEntry{
+		EntityType: tx.Statement.Schema.Name,
+		EntityID:   strings.Join(keys, ":"),
+		Operation:  operation,
+		Before:     before,
+		After:      after,
+		RequestID:  logging.RequestID(ctx),
+	}
+	if user := middleware.GetUserFromContext(ctx); user != nil {
+		result.ActorID = &user.ID
+	}
+	return result
+}
+
+func write(tx *gorm.DB, entries []db.AuditEntry) {
+	if len(entries) == 0 {
+		return
+	}
+	if err := tx.Session(&gorm.Session{NewDB: true, SkipHooks: true}).Create(&entries).Error; err != nil {
+		tx.AddError(fmt.Errorf("failed to write the audit log: %w", err))
+	}
+}
@@ -0,0 +1,156 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// bcrypt ignores everything past 72 bytes, so longer passwords are rejected outright
+const maxPasswordBytes = 72
+
+var ErrIncorrectPassword = errors.New("incorrect password")
+
+// PasswordPolicy describes the strength rules a new password must satisfy
+type PasswordPolicy struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+}
+
+// DefaultPasswordPolicy requires eight characters mixing upper case, lower case and digits
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:    8,
+		RequireUpper: true,
+		RequireLower: true,
+		RequireDigit: true,
+	}
+}
+
+// Validate reports every rule the password breaks
+func (p PasswordPolicy) Validate(password string) error {
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, c := range password {
+		switch {
+		case unicode.IsUpper(c):
+			hasUpper = true
+		case unicode.IsLower(c):
+			hasLower = true
+		case unicode.IsDigit(c):
+			hasDigit = true
+		case unicode.IsPunct(c) || unicode.IsSymbol(c):
+			hasSymbol = true
+		}
+	}
+
+	var problems []string
+	if len([]rune(password)) < p.MinLength {
+		problems = append(problems, fmt.Sprintf("be at least %d characters long", p.MinLength))
+	}
+	if len(password) > maxPasswordBytes {
+		problems = append(problems, fmt.Sprintf("be at most %d bytes long", maxPasswordBytes))
+	}
+	if p.RequireUpper && !hasUpper {
+		problems = append(problems, "contain an upper case letter")
+	}
+	if p.RequireLower && !hasLower {
+		problems = append(problems, "contain a lower case letter")
+	}
+	if p.RequireDigit && !hasDigit {
+		problems = append(problems, "contain a digit")
+	}
+	if p.RequireSymbol && !hasSymbol {
+		problems = append(problems, "contain a symbol")
+	}
+
+	if len(problems) > 0 {
+		return db.InvalidInput("password must %s", strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+// PasswordHasher is the single place passwords are checked against the policy and hashed
+type PasswordHasher struct {
+	Cost   int
+	Policy PasswordPolicy
+}
+
+// NewPasswordHasher creates a PasswordHasher
+func NewPasswordHasher(cost int, policy PasswordPolicy) *PasswordHasher {
+	return &PasswordHasher{
+		Cost:   cost,
+		Policy: policy,
+	}
+}
+
+// Hash validates a new password against the policy and returns its bcrypt hash
+func (h *PasswordHasher) Hash(password string) (string, error) {
+	if err := h.Policy.Validate(password); err != nil {
+		return "", err
+	}
+	return h.hash(password)
+}
+
+// Compare checks a password against a stored hash
+func (h *PasswordHasher) Compare(hash string, password string) error {
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil {
+		return ErrIncorrectPassword
+	}
+	return nil
+}
+
+func (h *PasswordHasher) hash(password string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), h.Cost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hashed), nil
+}
+
+// IsHashed reports whether a stored password value is already a bcrypt hash
+func IsHashed(value string) bool {
+	_, err := bcrypt.Cost([]byte(value))
+	return err == nil
+}
+
+// RehashPlaintextPasswords hashes any employee password still stored in plaintext.
+// Existing values are hashed as-is without applying the policy so nobody is locked out.
+// Rows that are already hashed are skipped, so running it again is a no-op.
+func RehashPlaintextPasswords(database *gorm.DB, h *PasswordHasher) (int, error) {
+	var employees []db.Employee
+	if err := database.Unscoped().Select("id", "password").
+		Where("password IS NOT NULL AND password <> '' AND password NOT LIKE ?", "$2_$%").
+		Find(&employees).Error; err != nil {
+		return 0, fmt.Errorf("failed to scan employee passwords: %w", err)
+	}
+
+	rehashed := 0
+	for _, employee := range employees {
+		if IsHashed(employee.Password) {
+			continue
+		}
+
+		hashed, err := h.hash(employee.Password)
+		if err != nil {
+			slog.Warn("could not rehash password", "employee_id", employee.ID, "error", err)
+			continue
+		}
+
+		if err := database.Unscoped().Model(&db.Employee{}).Where("id = ?", employee.ID).
+			Update("password", hashed).Error; err != nil {
+			return rehashed, fmt.Errorf("failed to store rehashed password: %w", err)
+		}
+		rehashed++
+	}
+
+	return rehashed, nil
+}
@@ -0,0 +1,229 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrUnauthenticated     = errors.New("unauthenticated")
+	ErrInvalidCredentials  = errors.New("invalid credentials")
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	ErrSessionRevoked      = errors.New("session has been revoked")
+	ErrAccountDeactivated  = errors.New("account is deactivated")
+)
+
+// IssuedTokens is the token pair handed to a client at sign in or refresh
+type IssuedTokens struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+	SessionID             int
+}
+
+// SessionManager issues access tokens backed by revocable refresh sessions
+type SessionManager struct {
+	DB         *gorm.DB
+	Tokens     *TokenManager
+	RefreshTTL time.Duration
+}
+
+// NewSessionManager creates a SessionManager
+func NewSessionManager(database *gorm.DB, tokens *TokenManager, refreshTTL time.Duration) *SessionManager {
+	return &SessionManager{
+		DB:         database,
+		Tokens:     tokens,
+		RefreshTTL: refreshTTL,
+	}
+}
+
+// Create opens a new session for the employee and issues its first token pair
+func (m *SessionManager) Create(ctx context.Context, employee *db.Employee) (*IssuedTokens, error) {
+	refreshToken, hash, err := newRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	session := db.Session{
+		EmployeeID:       employee.ID,
+		RefreshTokenHash: hash,
+		ExpiresAt:        time.Now().Add(m.RefreshTTL),
+	}
+
+	if err := m.DB.WithContext(ctx).Create(&session).Error; err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return m.issue(employee, &session, refreshToken)
+}
+
+// Refresh rotates the refresh token of a live session and issues a new token pair.
+// The presented refresh token stops working as soon as it has been used.
+func (m *SessionManager) Refresh(ctx context.Context, refreshToken string) (*IssuedTokens, *db.Employee, error) {
+	var (
+		tokens   *IssuedTokens
+		employee db.Employee
+	)
+
+	err := m.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var session db.Session
+		if err := tx.Where("refresh_token_hash = ?", hashRefreshToken(refreshToken)).First(&session).Error; err != nil {
+			return ErrInvalidRefreshToken
+		}
+
+		if session.RevokedAt != nil {
+			return ErrSessionRevoked
+		}
+		if time.Now().After(session.ExpiresAt) {
+			return ErrInvalidRefreshToken
+		}
+
+		if err := tx.First(&employee, session.EmployeeID).Error; err != nil {
+			return ErrInvalidRefreshToken
+		}
+		if !employee.Active {
+			return ErrAccountDeactivated
+		}
+
+		newToken, hash, err := newRefreshToken()
+		if err != nil {
+			return err
+		}
+
+		// Guard on the old hash so two concurrent refreshes cannot both succeed
+		result := tx.Model(&db.Session{}).
+			Where("id = ? AND refresh_token_hash = ?", session.ID, session.RefreshTokenHash).
+			Updates(map[string]interface{}{
+				"refresh_token_hash": hash,
+				"expires_at":         time.Now().Add(m.RefreshTTL),
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to rotate refresh token: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrInvalidRefreshToken
+		}
+
+		if err := tx.First(&session, session.ID).Error; err != nil {
+			return fmt.Errorf("failed to reload session: %w", err)
+		}
+
+		tokens, err = m.issue(&employee, &session, newToken)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tokens, &employee, nil
+}
+
+// Authenticate verifies an access token and the session behind it and returns the employee
+func (m *SessionManager) Authenticate(ctx context.Context, accessToken string) (*db.Employee, *Claims, error) {
+	claims, err := m.Tokens.ParseAccessToken(accessToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	employeeID, err := claims.EmployeeID()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var session db.Session
+	if err := m.DB.WithContext(ctx).Select("id", "employee_id", "expires_at", "revoked_at").First(&session, claims.SessionID).Error; err != nil {
+		return nil, nil, ErrSessionRevoked
+	}
+	if session.EmployeeID != employeeID || session.RevokedAt != nil || time.Now().After(session.ExpiresAt) {
+		return nil, nil, ErrSessionRevoked
+	}
+
+	var employee db.Employee
+	if err := m.DB.WithContext(ctx).Select("id", "role", "active").First(&employee, employeeID).Error; err != nil {
+		return nil, nil, ErrSessionRevoked
+	}
+	if !employee.Active {
+		return nil, nil, ErrAccountDeactivated
+	}
+
+	return &employee, claims, nil
+}
+
+// Revoke ends a single session
+func (m *SessionManager) Revoke(ctx context.Context, sessionID int) error {
+	if err := m.DB.WithContext(ctx).Model(&db.Session{}).
+		Where("id = ? AND revoked_at IS NULL", sessionID).
+		Update("revoked_at", time.Now()).Error; err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return nil
+}
+
+// RevokeAll ends every outstanding session of an employee
+func (m *SessionManager) RevokeAll(ctx context.Context, employeeID int) (int64, error) {
+	return RevokeEmployeeSessions(m.DB.WithContext(ctx), employeeID)
+}
+
+// RevokeOthers ends every outstanding session of an employee except the given one
+func (m *SessionManager) RevokeOthers(ctx context.Context, employeeID int, keepSessionID int) (int64, error) {
+	result := m.DB.WithContext(ctx).Model(&db.Session{}).
+		Where("employee_id = ? AND id <> ? AND revoked_at IS NULL", employeeID, keepSessionID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to revoke sessions: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// RevokeEmployeeSessions ends every outstanding session of an employee using the given
+// handle, so callers can revoke inside their own transaction
+func RevokeEmployeeSessions(tx *gorm.DB, employeeID int) (int64, error) {
+	result := tx.Model(&db.Session{}).
+		Where("employee_id = ? AND revoked_at IS NULL", employeeID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to revoke sessions: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+func (m *SessionManager) issue(employee *db.Employee, session *db.Session, refreshToken string) (*IssuedTokens, error) {
+	accessToken, expiresAt, err := m.Tokens.IssueAccessToken(employee.ID, session.ID, string(employee.Role))
+	if err != nil {
+		return nil, err
+	}
+
+	return &IssuedTokens{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  expiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: session.ExpiresAt,
+		SessionID:             session.ID,
+	}, nil
+}
+
+// newRefreshToken returns an opaque token for the client and the hash stored server-side
+func newRefreshToken() (string, string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(buf)
+	return token, hashRefreshToken(token), nil
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
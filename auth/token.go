@@ -0,0 +1,156 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Claims is the payload carried by an access token
+type Claims struct {
+	Role      string `json:"role"`
+	SessionID int    `json:"sid"`
+	jwt.RegisteredClaims
+}
+
+// EmployeeID returns the employee ID stored in the token subject
+func (c *Claims) EmployeeID() (int, error) {
+	id, err := strconv.Atoi(c.Subject)
+	if err != nil {
+		return 0, fmt.Errorf("invalid token subject: %w", err)
+	}
+	return id, nil
+}
+
+// TokenManager signs and verifies access tokens
+type TokenManager struct {
+	method    jwt.SigningMethod
+	signKey   interface{}
+	verifyKey interface{}
+	issuer    string
+	accessTTL time.Duration
+}
+
+// NewHMACTokenManager creates a TokenManager that signs tokens with HS256
+func NewHMACTokenManager(secret []byte, issuer string, accessTTL time.Duration) (*TokenManager, error) {
+	if len(secret) < 32 {
+		return nil, errors.New("HMAC secret must be at least 32 bytes")
+	}
+
+	return &TokenManager{
+		method:    jwt.SigningMethodHS256,
+		signKey:   secret,
+		verifyKey: secret,
+		issuer:    issuer,
+		accessTTL: accessTTL,
+	}, nil
+}
+
+// NewEd25519TokenManager creates a TokenManager that signs tokens with EdDSA
+func NewEd25519TokenManager(privateKey ed25519.PrivateKey, issuer string, accessTTL time.Duration) (*TokenManager, error) {
+	if len(privateKey) != ed25519.PrivateKeySize {
+		return nil, errors.New("invalid Ed25519 private key")
+	}
+
+	return &TokenManager{
+		method:    jwt.SigningMethodEdDSA,
+		signKey:   privateKey,
+		verifyKey: privateKey.Public(),
+		issuer:    issuer,
+		accessTTL: accessTTL,
+	}, nil
+}
+
+// NewTokenManager builds a TokenManager from an Ed25519 key file or, failing that, an
+// HMAC secret
+func NewTokenManager(privateKeyFile, secret, issuer string, accessTTL time.Duration) (*TokenManager, error) {
+	if privateKeyFile != "" {
+		privateKey, err := LoadEd25519PrivateKey(privateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		return NewEd25519TokenManager(privateKey, issuer, accessTTL)
+	}
+
+	if secret != "" {
+		return NewHMACTokenManager([]byte(secret), issuer, accessTTL)
+	}
+
+	return nil, errors.New("no signing key configured: set JWT_ED25519_PRIVATE_KEY_FILE or JWT_SECRET")
+}
+
+// LoadEd25519PrivateKey reads a PKCS#8 PEM encoded Ed25519 private key
+func LoadEd25519PrivateKey(path string) (ed25519.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("failed to decode private key PEM")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	privateKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not an Ed25519 key")
+	}
+	return privateKey, nil
+}
+
+// AccessTTL returns how long issued access tokens stay valid
+func (m *TokenManager) AccessTTL() time.Duration {
+	return m.accessTTL
+}
+
+// IssueAccessToken signs a new access token for the given employee and session
+func (m *TokenManager) IssueAccessToken(employeeID int, sessionID int, role string) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(m.accessTTL)
+
+	claims := Claims{
+		Role:      role,
+		SessionID: sessionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    m.issuer,
+			Subject:   strconv.Itoa(employeeID),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(m.method, claims).SignedString(m.signKey)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign access token: %w", err)
+	}
+	return token, expiresAt, nil
+}
+
+// ParseAccessToken verifies the signature, issuer and expiry of a token and returns its claims
+func (m *TokenManager) ParseAccessToken(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return m.verifyKey, nil
+	},
+		jwt.WithValidMethods([]string{m.method.Alg()}),
+		jwt.WithIssuer(m.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid access token: %w", err)
+	}
+	return claims, nil
+}
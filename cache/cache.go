@@ -0,0 +1,130 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Backend stores cached responses. Entries carry tags naming the tables they were built
+// from so that a write to a table can drop every entry that depends on it.
+type Backend interface {
+	Get(key string) (interface{}, bool)
+	Set(key string, value interface{}, ttl time.Duration, tags []string)
+	InvalidateTags(tags ...string)
+}
+
+// LRU is an in-memory Backend that evicts the least recently used entry when full
+type LRU struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	entries  map[string]*list.Element
+	tagIndex map[string]map[string]struct{}
+}
+
+type lruEntry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+	tags      []string
+}
+
+// NewLRU creates an LRU holding at most capacity entries
+func NewLRU(capacity int) *LRU {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &LRU{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+		tagIndex: make(map[string]map[string]struct{}),
+	}
+}
+
+func (c *LRU) Get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*lruEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.remove(elem)
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+func (c *LRU) Set(key string, value interface{}, ttl time.Duration, tags []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+
+	entry := &lruEntry{
+		key:       key,
+		value:     value,
+		expiresAt: time.Now().Add(ttl),
+		tags:      tags,
+	}
+	c.entries[key] = c.order.PushFront(entry)
+
+	for _, tag := range tags {
+		keys, ok := c.tagIndex[tag]
+		if !ok {
+			keys = make(map[string]struct{})
+			c.tagIndex[tag] = keys
+		}
+		keys[key] = struct{}{}
+	}
+
+	for c.order.Len() > c.capacity {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *LRU) InvalidateTags(tags ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, tag := range tags {
+		for key := range c.tagIndex[tag] {
+			if elem, ok := c.entries[key]; ok {
+				c.remove(elem)
+			}
+		}
+		delete(c.tagIndex, tag)
+	}
+}
+
+// Len returns the number of cached entries
+func (c *LRU) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *LRU) remove(elem *list.Element) {
+	entry := elem.Value.(*lruEntry)
+	c.order.Remove(elem)
+	delete(c.entries, entry.key)
+
+	for _, tag := range entry.tags {
+		if keys, ok := c.tagIndex[tag]; ok {
+			delete(keys, entry.key)
+			if len(keys) == 0 {
+				delete(c.tagIndex, tag)
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"context"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+const pluginName = "cache:invalidation"
+
+// invalidation is the GORM plugin behind RegisterInvalidation
+type invalidation struct {
+	backend Backend
+}
+
+func (p *invalidation) Name() string {
+	return pluginName
+}
+
+func (p *invalidation) Initialize(database *gorm.DB) error {
+	// Running after the default transaction commits keeps a concurrent read from caching
+	// the old rows again between the invalidation and the commit
+	invalidate := func(tx *gorm.DB) {
+		if tx.Error != nil || tx.Statement.Table == "" {
+			return
+		}
+		p.invalidate(tx.Statement.Context, tx.Statement.Table)
+	}
+
+	callbacks := database.Callback()
+	if err := callbacks.Create().After("gorm:commit_or_rollback_transaction").Register("cache:invalidate", invalidate); err != nil {
+		return err
+	}
+	if err := callbacks.Update().After("gorm:commit_or_rollback_transaction").Register("cache:invalidate", invalidate); err != nil {
+		return err
+	}
+	if err := callbacks.Delete().After("gorm:commit_or_rollback_transaction").Register("cache:invalidate", invalidate); err != nil {
+		return err
+	}
+	return nil
+}
+
+// invalidate drops the entries tagged with tables, or holds them back until the
+// surrounding Transaction commits
+func (p *invalidation) invalidate(ctx context.Context, tables ...string) {
+	if ctx != nil {
+		if held, ok := ctx.Value(pendingKey{}).(*pending); ok {
+			held.add(tables...)
+			return
+		}
+	}
+	p.backend.InvalidateTags(tables...)
+}
+
+type pendingKey struct{}
+
+// pending collects the tables written by a transaction that has not committed yet
+type pending struct {
+	mu     sync.Mutex
+	tables []string
+}
+
+func (p *pending) add(tables ...string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.tables = append(p.tables, tables...)
+}
+
+// RegisterInvalidation hooks GORM so every successful create, update or delete drops the
+// cached entries tagged with the written table. Because it runs on the statement rather
+// than in the resolvers, new mutations are covered without further wiring.
+func RegisterInvalidation(database *gorm.DB, backend Backend) error {
+	return database.Use(&invalidation{backend: backend})
+}
+
+// Transaction runs fn in a transaction like gorm.DB.Transaction, but invalidates the
+// tables it wrote only once it has committed. Nested calls leave that to the outermost.
+func Transaction(ctx context.Context, database *gorm.DB, fn func(tx *gorm.DB) error) error {
+	plugin, ok := database.Config.Plugins[pluginName].(*invalidation)
+	if _, nested := ctx.Value(pendingKey{}).(*pending); !ok || nested {
+		return database.WithContext(ctx).Transaction(fn)
+	}
+
+	held := &pending{}
+	if err := database.WithContext(context.WithValue(ctx, pendingKey{}, held)).Transaction(fn); err != nil {
+		return err
+	}
+	if len(held.tables) > 0 {
+		plugin.backend.InvalidateTags(held.tables...)
+	}
+	return nil
+}
+
+// Invalidate drops the cached entries of tables written where the callbacks cannot see
+// it, such as raw SQL or the cascade of a foreign key. Inside Transaction it waits for
+// the commit.
+func Invalidate(tx *gorm.DB, tables ...string) {
+	if plugin, ok := tx.Config.Plugins[pluginName].(*invalidation); ok {
+		plugin.invalidate(tx.Statement.Context, tables...)
+	}
+}
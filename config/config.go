@@ -0,0 +1,412 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/yaml.v3"
+)
+
+// maxPasswordLength is bcrypt's input limit; a longer minimum could never be met
+const maxPasswordLength = 72
+
+// Config is everything the server reads at startup
+type Config struct {
+	Database Database `yaml:"database"`
+	Server   Server   `yaml:"server"`
+	Auth     Auth     `yaml:"auth"`
+	Tracing  Tracing  `yaml:"tracing"`
+	Logging  Logging  `yaml:"logging"`
+	Trash    Trash    `yaml:"trash"`
+}
+
+// Database configures the Postgres connection pool
+type Database struct {
+	URL             string        `yaml:"url"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	// Statements slower than this are logged at warn
+	SlowQueryThreshold time.Duration `yaml:"slow_query_threshold"`
+}
+
+// Deployment environments
+const (
+	EnvironmentDevelopment = "development"
+	EnvironmentProduction  = "production"
+)
+
+// Server configures the HTTP listener
+type Server struct {
+	// production hides the cause of internal errors from clients; development returns it
+	Environment string `yaml:"environment"`
+	Port        int    `yaml:"port"`
+	// Only trust X-Forwarded-For when a proxy in front of the service overwrites it
+	TrustProxyHeaders bool `yaml:"trust_proxy_headers"`
+	// Origins allowed to call /query from a browser; empty disables CORS
+	CORSAllowedOrigins []string `yaml:"cors_allowed_origins"`
+
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout"`
+	ReadTimeout       time.Duration `yaml:"read_timeout"`
+	WriteTimeout      time.Duration `yaml:"write_timeout"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout"`
+	// How long in-flight requests may take to finish after SIGTERM
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+// Auth configures tokens and password hashing. An Ed25519 key file takes precedence
+// over an HMAC secret.
+type Auth struct {
+	Issuer                string        `yaml:"issuer"`
+	AccessTTL             time.Duration `yaml:"access_ttl"`
+	RefreshTTL            time.Duration `yaml:"refresh_ttl"`
+	Secret                string        `yaml:"secret"`
+	Ed25519PrivateKeyFile string        `yaml:"ed25519_private_key_file"`
+	BcryptCost            int           `yaml:"bcrypt_cost"`
+	Password              Password      `yaml:"password"`
+}
+
+// Password is the strength policy for new passwords
+type Password struct {
+	MinLength     int  `yaml:"min_length"`
+	RequireUpper  bool `yaml:"require_upper"`
+	RequireLower  bool `yaml:"require_lower"`
+	RequireDigit  bool `yaml:"require_digit"`
+	RequireSymbol bool `yaml:"require_symbol"`
+}
+
+// Trace exporters
+const (
+	TracingNone   = "none"
+	TracingStdout = "stdout"
+	TracingOTLP   = "otlp"
+)
+
+// Tracing configures OpenTelemetry span export
+type Tracing struct {
+	Exporter string `yaml:"exporter"`
+	// OTLP/HTTP collector URL, e.g. http://localhost:4318; empty uses the SDK default
+	Endpoint    string  `yaml:"endpoint"`
+	ServiceName string  `yaml:"service_name"`
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
+// Logging configures the JSON logger
+type Logging struct {
+	// debug, info, warn or error; debug includes every SQL statement
+	Level string `yaml:"level"`
+}
+
+// Trash configures how long soft-deleted rows can be restored
+type Trash struct {
+	// purgeTrash only removes rows deleted longer ago than this
+	Retention time.Duration `yaml:"retention"`
+}
+
+// SlogLevel parses Level; Validate has already rejected unknown names
+func (l Logging) SlogLevel() slog.Level {
+	var level slog.Level
+	level.UnmarshalText([]byte(l.Level))
+	return level
+}
+
+// Default returns the settings used when nothing overrides them
+func Default() Config {
+	return Config{
+		Database: Database{
+			MaxIdleConns:       5,
+			MaxOpenConns:       10,
+			SlowQueryThreshold: 200 * time.Millisecond,
+		},
+		Server: Server{
+			Environment:       EnvironmentProduction,
+			Port:              8080,
+			ReadHeaderTimeout: 5 * time.Second,
+			ReadTimeout:       15 * time.Second,
+			WriteTimeout:      30 * time.Second,
+			IdleTimeout:       2 * time.Minute,
+			ShutdownTimeout:   20 * time.Second,
+		},
+		Auth: Auth{
+			Issuer:     "project-management-system-api",
+			AccessTTL:  15 * time.Minute,
+			RefreshTTL: 30 * 24 * time.Hour,
+			BcryptCost: bcrypt.DefaultCost,
+			Password: Password{
+				MinLength:    8,
+				RequireUpper: true,
+				RequireLower: true,
+				RequireDigit: true,
+			},
+		},
+		Tracing: Tracing{
+			Exporter:    TracingNone,
+			ServiceName: "project-management-system-api",
+			SampleRatio: 1,
+		},
+		Logging: Logging{
+			Level: "info",
+		},
+		Trash: Trash{
+			Retention: 30 * 24 * time.Hour,
+		},
+	}
+}
+
+// setting is one value that can be overridden by an environment variable and, unless
+// it is a secret, a command line flag
+type setting struct {
+	env    string
+	flag   string
+	usage  string
+	isBool bool
+	apply  func(c *Config, raw string) error
+}
+
+func stringSetting(env, flag, usage string, field func(c *Config) *string) setting {
+	return setting{env: env, flag: flag, usage: usage, apply: func(c *Config, raw string) error {
+		*field(c) = raw
+		return nil
+	}}
+}
+
+func intSetting(env, flag, usage string, field func(c *Config) *int) setting {
+	return setting{env: env, flag: flag, usage: usage, apply: func(c *Config, raw string) error {
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return err
+		}
+		*field(c) = n
+		return nil
+	}}
+}
+
+func boolSetting(env, flag, usage string, field func(c *Config) *bool) setting {
+	return setting{env: env, flag: flag, usage: usage, isBool: true, apply: func(c *Config, raw string) error {
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		*field(c) = b
+		return nil
+	}}
+}
+
+func durationSetting(env, flag, usage string, field func(c *Config) *time.Duration) setting {
+	return setting{env: env, flag: flag, usage: usage, apply: func(c *Config, raw string) error {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		*field(c) = d
+		return nil
+	}}
+}
+
+func floatSetting(env, flag, usage string, field func(c *Config) *float64) setting {
+	return setting{env: env, flag: flag, usage: usage, apply: func(c *Config, raw string) error {
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		*field(c) = f
+		return nil
+	}}
+}
+
+// listSetting reads a comma separated list
+func listSetting(env, flag, usage string, field func(c *Config) *[]string) setting {
+	return setting{env: env, flag: flag, usage: usage, apply: func(c *Config, raw string) error {
+		var items []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		*field(c) = items
+		return nil
+	}}
+}
+
+// Secrets have no flag: command lines are visible to every user on the host
+var settings = []setting{
+	stringSetting("DATABASE_URL", "", "Postgres connection string", func(c *Config) *string { return &c.Database.URL }),
+	intSetting("DB_MAX_IDLE_CONNS", "db-max-idle-conns", "idle connections kept in the pool", func(c *Config) *int { return &c.Database.MaxIdleConns }),
+	intSetting("DB_MAX_OPEN_CONNS", "db-max-open-conns", "open connections allowed, 0 for no limit", func(c *Config) *int { return &c.Database.MaxOpenConns }),
+	durationSetting("DB_SLOW_QUERY_THRESHOLD", "db-slow-query-threshold", "log statements slower than this at warn", func(c *Config) *time.Duration { return &c.Database.SlowQueryThreshold }),
+	durationSetting("DB_CONN_MAX_LIFETIME", "db-conn-max-lifetime", "how long a connection is reused, 0 for forever", func(c *Config) *time.Duration { return &c.Database.ConnMaxLifetime }),
+
+	stringSetting("APP_ENV", "env", "development or production", func(c *Config) *string { return &c.Server.Environment }),
+	intSetting("PORT", "port", "HTTP port", func(c *Config) *int { return &c.Server.Port }),
+	boolSetting("TRUST_PROXY_HEADERS", "trust-proxy-headers", "take the client IP from X-Forwarded-For", func(c *Config) *bool { return &c.Server.TrustProxyHeaders }),
+	listSetting("CORS_ALLOWED_ORIGINS", "cors-allowed-origins", "comma separated origins allowed by CORS, * for any", func(c *Config) *[]string { return &c.Server.CORSAllowedOrigins }),
+	durationSetting("HTTP_READ_HEADER_TIMEOUT", "http-read-header-timeout", "time allowed to read request headers", func(c *Config) *time.Duration { return &c.Server.ReadHeaderTimeout }),
+	durationSetting("HTTP_READ_TIMEOUT", "http-read-timeout", "time allowed to read a whole request", func(c *Config) *time.Duration { return &c.Server.ReadTimeout }),
+	durationSetting("HTTP_WRITE_TIMEOUT", "http-write-timeout", "time allowed to write a response", func(c *Config) *time.Duration { return &c.Server.WriteTimeout }),
+	durationSetting("HTTP_IDLE_TIMEOUT", "http-idle-timeout", "how long idle keep-alive connections stay open", func(c *Config) *time.Duration { return &c.Server.IdleTimeout }),
+	durationSetting("SHUTDOWN_TIMEOUT", "shutdown-timeout", "how long to drain requests on SIGTERM", func(c *Config) *time.Duration { return &c.Server.ShutdownTimeout }),
+
+	stringSetting("JWT_ISSUER", "jwt-issuer", "issuer claim of access tokens", func(c *Config) *string { return &c.Auth.Issuer }),
+	durationSetting("JWT_ACCESS_TTL", "jwt-access-ttl", "access token lifetime", func(c *Config) *time.Duration { return &c.Auth.AccessTTL }),
+	durationSetting("JWT_REFRESH_TTL", "jwt-refresh-ttl", "refresh token lifetime", func(c *Config) *time.Duration { return &c.Auth.RefreshTTL }),
+	stringSetting("JWT_SECRET", "", "HMAC signing secret", func(c *Config) *string { return &c.Auth.Secret }),
+	stringSetting("JWT_ED25519_PRIVATE_KEY_FILE", "jwt-ed25519-private-key-file", "PKCS#8 PEM Ed25519 signing key", func(c *Config) *string { return &c.Auth.Ed25519PrivateKeyFile }),
+	intSetting("BCRYPT_COST", "bcrypt-cost", "bcrypt work factor", func(c *Config) *int { return &c.Auth.BcryptCost }),
+	intSetting("PASSWORD_MIN_LENGTH", "password-min-length", "minimum password length", func(c *Config) *int { return &c.Auth.Password.MinLength }),
+	boolSetting("PASSWORD_REQUIRE_UPPER", "password-require-upper", "passwords need an upper case letter", func(c *Config) *bool { return &c.Auth.Password.RequireUpper }),
+	boolSetting("PASSWORD_REQUIRE_LOWER", "password-require-lower", "passwords need a lower case letter", func(c *Config) *bool { return &c.Auth.Password.RequireLower }),
+	boolSetting("PASSWORD_REQUIRE_DIGIT", "password-require-digit", "passwords need a digit", func(c *Config) *bool { return &c.Auth.Password.RequireDigit }),
+	boolSetting("PASSWORD_REQUIRE_SYMBOL", "password-require-symbol", "passwords need a symbol", func(c *Config) *bool { return &c.Auth.Password.RequireSymbol }),
+
+	stringSetting("TRACING_EXPORTER", "tracing-exporter", "where spans go: none, stdout or otlp", func(c *Config) *string { return &c.Tracing.Exporter }),
+	stringSetting("OTEL_EXPORTER_OTLP_ENDPOINT", "otlp-endpoint", "OTLP/HTTP collector URL", func(c *Config) *string { return &c.Tracing.Endpoint }),
+	stringSetting("OTEL_SERVICE_NAME", "service-name", "service.name on exported spans", func(c *Config) *string { return &c.Tracing.ServiceName }),
+	floatSetting("TRACING_SAMPLE_RATIO", "tracing-sample-ratio", "share of new traces to sample, 0 to 1", func(c *Config) *float64 { return &c.Tracing.SampleRatio }),
+
+	stringSetting("LOG_LEVEL", "log-level", "debug, info, warn or error", func(c *Config) *string { return &c.Logging.Level }),
+
+	durationSetting("TRASH_RETENTION", "trash-retention", "how long deleted rows stay restorable", func(c *Config) *time.Duration { return &c.Trash.Retention }),
+}
+
+// Load builds the configuration from, in increasing precedence: defaults, the YAML file
+// named by -config or CONFIG_FILE, environment variables and command line flags. It
+// returns the arguments left after the flags, e.g. a subcommand.
+func Load(args []string, getenv func(string) string) (*Config, []string, error) {
+	flags := flag.NewFlagSet("project-management-system-api", flag.ContinueOnError)
+	path := flags.String("config", getenv("CONFIG_FILE"), "YAML config file")
+
+	type override struct {
+		setting setting
+		raw     string
+	}
+	var overrides []override
+	for _, s := range settings {
+		if s.flag == "" {
+			continue
+		}
+		record := func(raw string) error {
+			overrides = append(overrides, override{s, raw})
+			return nil
+		}
+		if s.isBool {
+			flags.BoolFunc(s.flag, s.usage, record)
+		} else {
+			flags.Func(s.flag, s.usage, record)
+		}
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, nil, err
+	}
+
+	cfg := Default()
+	if *path != "" {
+		if err := cfg.loadFile(*path); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, s := range settings {
+		raw := getenv(s.env)
+		if raw == "" {
+			continue
+		}
+		if err := s.apply(&cfg, raw); err != nil {
+			return nil, nil, fmt.Errorf("invalid %s: %q", s.env, raw)
+		}
+	}
+	for _, o := range overrides {
+		if err := o.setting.apply(&cfg, o.raw); err != nil {
+			return nil, nil, fmt.Errorf("invalid -%s: %q", o.setting.flag, o.raw)
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, nil, err
+	}
+	return &cfg, flags.Args(), nil
+}
+
+// loadFile overlays a YAML file; unknown keys are rejected so typos do not go unnoticed
+func (c *Config) loadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open config file: %w", err)
+	}
+	defer file.Close()
+
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Validate reports every invalid setting at once
+func (c *Config) Validate() error {
+	var problems []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			problems = append(problems, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(c.Database.URL != "", "DATABASE_URL is required")
+	check(c.Database.MaxIdleConns >= 0, "database max idle connections must not be negative")
+	check(c.Database.MaxOpenConns >= 0, "database max open connections must not be negative")
+	check(c.Database.ConnMaxLifetime >= 0, "database connection lifetime must not be negative")
+	check(c.Database.SlowQueryThreshold >= 0, "slow query threshold must not be negative")
+
+	check(c.Server.Environment == EnvironmentDevelopment || c.Server.Environment == EnvironmentProduction,
+		"environment %q must be development or production", c.Server.Environment)
+	check(c.Server.Port > 0 && c.Server.Port <= 65535, "port %d is out of range", c.Server.Port)
+	check(c.Server.ReadHeaderTimeout > 0 && c.Server.ReadTimeout > 0 && c.Server.WriteTimeout > 0 && c.Server.IdleTimeout > 0,
+		"HTTP timeouts must be positive")
+	check(c.Server.ShutdownTimeout > 0, "shutdown timeout must be positive")
+	for _, origin := range c.Server.CORSAllowedOrigins {
+		check(validOrigin(origin), "CORS origin %q must be * or scheme://host[:port]", origin)
+	}
+
+	check(c.Auth.Issuer != "", "token issuer is required")
+	check(c.Auth.AccessTTL > 0, "access token TTL must be positive")
+	check(c.Auth.RefreshTTL > 0, "refresh token TTL must be positive")
+	check(c.Auth.BcryptCost >= bcrypt.MinCost && c.Auth.BcryptCost <= bcrypt.MaxCost,
+		"bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+	check(c.Auth.Password.MinLength >= 1 && c.Auth.Password.MinLength <= maxPasswordLength,
+		"password minimum length must be between 1 and %d", maxPasswordLength)
+
+	check(c.Tracing.Exporter == TracingNone || c.Tracing.Exporter == TracingStdout || c.Tracing.Exporter == TracingOTLP,
+		"trace exporter %q must be none, stdout or otlp", c.Tracing.Exporter)
+	check(c.Tracing.Endpoint == "" || validEndpoint(c.Tracing.Endpoint), "OTLP endpoint %q must be an http(s) URL", c.Tracing.Endpoint)
+	check(c.Tracing.ServiceName != "", "tracing service name is required")
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "trace sample ratio must be between 0 and 1")
+
+	var level slog.Level
+	check(level.UnmarshalText([]byte(c.Logging.Level)) == nil, "log level %q must be debug, info, warn or error", c.Logging.Level)
+
+	check(c.Trash.Retention >= 0, "trash retention must not be negative")
+
+	return errors.Join(problems...)
+}
+
+func validOrigin(origin string) bool {
+	if origin == "*" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" &&
+		u.Path == "" && u.RawQuery == "" && u.User == nil
+}
+
+func validEndpoint(endpoint string) bool {
+	u, err := url.Parse(endpoint)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
@@ -0,0 +1,39 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidInput matches errors caused by the caller's arguments, such as a bad
+	// filter date or sort field. Their messages are meant for the client.
+	ErrInvalidInput = errors.New("invalid input")
+	// ErrConflict matches writes that clash with existing rows, such as a second employee
+	// with the same email. Their messages are meant for the client.
+	ErrConflict = errors.New("conflict")
+)
+
+// kindError carries a client-facing message and matches the sentinel of its kind
+type kindError struct {
+	kind    error
+	message string
+}
+
+func (e *kindError) Error() string {
+	return e.message
+}
+
+func (e *kindError) Is(target error) bool {
+	return target == e.kind
+}
+
+// InvalidInput formats an error that matches ErrInvalidInput
+func InvalidInput(format string, args ...any) error {
+	return &kindError{kind: ErrInvalidInput, message: fmt.Sprintf(format, args...)}
+}
+
+// Conflict formats an error that matches ErrConflict
+func Conflict(format string, args ...any) error {
+	return &kindError{kind: ErrConflict, message: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,202 @@
+package db
+
+import (
+	"strings"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"gorm.io/gorm"
+)
+
+// Scope is a reusable query modifier, applied with gorm.DB.Scopes
+type Scope = func(*gorm.DB) *gorm.DB
+
+// Contains matches rows whose column contains value, ignoring case. Nil or empty values
+// leave the query unchanged.
+func Contains(column string, value *string) Scope {
+	return func(query *gorm.DB) *gorm.DB {
+		if value == nil || *value == "" {
+			return query
+		}
+		return query.Where(column+" ILIKE ?", "%"+escapeLike(*value)+"%")
+	}
+}
+
+// Equals matches rows whose column equals value. Nil values leave the query unchanged.
+func Equals[T any](column string, value *T) Scope {
+	return func(query *gorm.DB) *gorm.DB {
+		if value == nil {
+			return query
+		}
+		return query.Where(column+" = ?", *value)
+	}
+}
+
+// Between matches rows whose column lies within the inclusive range. Either bound may be nil.
+func Between[T any](column string, after *T, before *T) Scope {
+	return func(query *gorm.DB) *gorm.DB {
+		if after != nil {
+			query = query.Where(column+" >= ?", *after)
+		}
+		if before != nil {
+			query = query.Where(column+" <= ?", *before)
+		}
+		return query
+	}
+}
+
+// DateBetween is Between for Date scalars, which arrive as YYYY-MM-DD strings
+func DateBetween(column string, after *string, before *string) Scope {
+	return func(query *gorm.DB) *gorm.DB {
+		afterDate, err := parseDate(after)
+		if err != nil {
+			query.AddError(err)
+			return query
+		}
+		beforeDate, err := parseDate(before)
+		if err != nil {
+			query.AddError(err)
+			return query
+		}
+		return Between(column, afterDate, beforeDate)(query)
+	}
+}
+
+// EmployeeFilterScope applies every EmployeeFilter field
+func EmployeeFilterScope(filter *model.EmployeeFilter) Scope {
+	return func(query *gorm.DB) *gorm.DB {
+		if filter == nil {
+			return query
+		}
+		return query.Scopes(
+			Contains("employees.name", filter.Name),
+			Contains("employees.email", filter.Email),
+			Equals("employees.role", mapPtr(filter.Role, RoleToDB)),
+			Equals("employees.active", filter.Active),
+			Equals("employees.project_assigned_id", filter.ProjectAssignedID),
+			Between("employees.created_at", filter.CreatedAtAfter, filter.CreatedAtBefore),
+		)
+	}
+}
+
+// ProjectFilterScope applies every ProjectFilter field
+func ProjectFilterScope(filter *model.ProjectFilter) Scope {
+	return func(query *gorm.DB) *gorm.DB {
+		if filter == nil {
+			return query
+		}
+		return query.Scopes(
+			Contains("projects.name", filter.Name),
+			Equals("projects.status", mapPtr(filter.Status, StatusToDB)),
+			Equals("projects.manager_id", filter.ManagerID),
+			Between("projects.start_date", filter.StartDateAfter, filter.StartDateBefore),
+			Between("projects.created_at", filter.CreatedAtAfter, filter.CreatedAtBefore),
+		)
+	}
+}
+
+// TaskFilterScope applies every TaskFilter field
+func TaskFilterScope(filter *model.TaskFilter) Scope {
+	return func(query *gorm.DB) *gorm.DB {
+		if filter == nil {
+			return query
+		}
+		return query.Scopes(
+			Contains("tasks.title", filter.Title),
+			Equals("tasks.status", mapPtr(filter.Status, StatusToDB)),
+			Equals("tasks.priority", mapPtr(filter.Priority, PriorityToDB)),
+			Equals("tasks.assigned_to_id", filter.AssignedToID),
+			Equals("tasks.project_id", filter.ProjectID),
+			DateBetween("tasks.due_date", filter.DueDateAfter, filter.DueDateBefore),
+			Between("tasks.created_at", filter.CreatedAtAfter, filter.CreatedAtBefore),
+		)
+	}
+}
+
+// TicketFilterScope applies every TicketFilter field
+func TicketFilterScope(filter *model.TicketFilter) Scope {
+	return func(query *gorm.DB) *gorm.DB {
+		if filter == nil {
+			return query
+		}
+		return query.Scopes(
+			Contains("tickets.title", filter.Title),
+			Equals("tickets.status", mapPtr(filter.Status, StatusToDB)),
+			Equals("tickets.priority", mapPtr(filter.Priority, PriorityToDB)),
+			Equals("tickets.project_id", filter.ProjectID),
+			Equals("tickets.assigned_to_id", filter.AssignedToID),
+			Between("tickets.created_at", filter.CreatedAtAfter, filter.CreatedAtBefore),
+		)
+	}
+}
+
+// TeamFilterScope applies every TeamFilter field
+func TeamFilterScope(filter *model.TeamFilter) Scope {
+	return func(query *gorm.DB) *gorm.DB {
+		if filter == nil {
+			return query
+		}
+		return query.Scopes(
+			Contains("teams.name", filter.Name),
+			Equals("teams.team_leader_id", filter.TeamLeaderID),
+			Between("teams.created_at", filter.CreatedAtAfter, filter.CreatedAtBefore),
+		)
+	}
+}
+
+// NotificationFilterScope applies every NotificationFilter field
+func NotificationFilterScope(filter *model.NotificationFilter) Scope {
+	return func(query *gorm.DB) *gorm.DB {
+		if filter == nil {
+			return query
+		}
+		return query.Scopes(
+			Equals("notifications.type", mapPtr(filter.Type, NotificationTypeToDB)),
+			Equals("notifications.read", filter.Read),
+			Between("notifications.created_at", filter.CreatedAtAfter, filter.CreatedAtBefore),
+		)
+	}
+}
+
+// AuditLogFilterScope applies every AuditLogFilter field
+func AuditLogFilterScope(filter *model.AuditLogFilter) Scope {
+	return func(query *gorm.DB) *gorm.DB {
+		if filter == nil {
+			return query
+		}
+		return query.Scopes(
+			Equals("audit_entries.actor_id", filter.ActorID),
+			Equals("audit_entries.entity_type", filter.EntityType),
+			Equals("audit_entries.entity_id", filter.EntityID),
+			Equals("audit_entries.operation", mapPtr(filter.Operation, AuditOperationToDB)),
+			Equals("audit_entries.request_id", filter.RequestID),
+			Between("audit_entries.created_at", filter.CreatedAtAfter, filter.CreatedAtBefore),
+		)
+	}
+}
+
+func mapPtr[T any, U any](value *T, convert func(T) U) *U {
+	if value == nil {
+		return nil
+	}
+	converted := convert(*value)
+	return &converted
+}
+
+func parseDate(value *string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+	date, err := time.Parse("2006-01-02", *value)
+	if err != nil {
+		return nil, InvalidInput("invalid date %q: expected YYYY-MM-DD", *value)
+	}
+	return &date, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike stops user input from being interpreted as LIKE wildcards
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
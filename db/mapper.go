@@ -21,3 +21,18 @@ func StatusToDB(status model.Status) StatusDB {
 func StatusToModel(status StatusDB) model.Status {
 	return model.Status(status)
 }
+
+// PriorityToDB converts GraphQL Priority to its database column value
+func PriorityToDB(priority model.Priority) string {
+	return string(priority)
+}
+
+// NotificationTypeToDB converts GraphQL NotificationType to its database column value
+func NotificationTypeToDB(notificationType model.NotificationType) string {
+	return string(notificationType)
+}
+
+// AuditOperationToDB converts GraphQL AuditOperation to database AuditOperation
+func AuditOperationToDB(operation model.AuditOperation) AuditOperation {
+	return AuditOperation(operation)
+}
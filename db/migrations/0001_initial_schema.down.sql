@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS project_employees;
+DROP TABLE IF EXISTS project_teams;
+DROP TABLE IF EXISTS team_engineers;
+DROP TABLE IF EXISTS notifications;
+DROP TABLE IF EXISTS tasks;
+DROP TABLE IF EXISTS tickets;
+ALTER TABLE IF EXISTS employees DROP CONSTRAINT IF EXISTS fk_employee_project;
+DROP TABLE IF EXISTS projects;
+DROP TABLE IF EXISTS teams;
+DROP TABLE IF EXISTS employees;
@@ -0,0 +1,167 @@
+-- Tables as created by the original AutoMigrate. Every statement is idempotent so
+-- databases bootstrapped before versioned migrations adopt this version unchanged.
+CREATE TABLE IF NOT EXISTS employees (
+    id                  bigserial PRIMARY KEY,
+    name                text NOT NULL,
+    email               text NOT NULL,
+    password            text,
+    role                varchar(20) NOT NULL,
+    active              boolean NOT NULL DEFAULT true,
+    project_assigned_id bigint,
+    created_at          timestamptz,
+    updated_at          timestamptz,
+    deleted_at          timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_employees_email ON employees (email);
+CREATE INDEX IF NOT EXISTS idx_employees_project_assigned_id ON employees (project_assigned_id);
+CREATE INDEX IF NOT EXISTS idx_employees_deleted_at ON employees (deleted_at);
+
+CREATE TABLE IF NOT EXISTS teams (
+    id             bigserial PRIMARY KEY,
+    team_leader_id bigint,
+    name           text NOT NULL,
+    description    text,
+    created_at     timestamptz,
+    updated_at     timestamptz,
+    deleted_at     timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_teams_team_leader_id ON teams (team_leader_id);
+CREATE INDEX IF NOT EXISTS idx_teams_deleted_at ON teams (deleted_at);
+
+CREATE TABLE IF NOT EXISTS projects (
+    id          bigserial PRIMARY KEY,
+    manager_id  bigint,
+    name        text NOT NULL,
+    status      varchar(20) NOT NULL,
+    description text,
+    start_date  timestamptz,
+    created_at  timestamptz,
+    updated_at  timestamptz,
+    deleted_at  timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_projects_manager_id ON projects (manager_id);
+CREATE INDEX IF NOT EXISTS idx_projects_deleted_at ON projects (deleted_at);
+
+CREATE TABLE IF NOT EXISTS tickets (
+    id             bigserial PRIMARY KEY,
+    project_id     bigint NOT NULL,
+    assigned_to_id bigint,
+    status         varchar(20) NOT NULL,
+    title          text NOT NULL,
+    description    text,
+    priority       varchar(20) DEFAULT 'MEDIUM',
+    created_at     timestamptz,
+    completed_at   timestamptz,
+    deleted_at     timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_tickets_project_id ON tickets (project_id);
+CREATE INDEX IF NOT EXISTS idx_tickets_assigned_to_id ON tickets (assigned_to_id);
+CREATE INDEX IF NOT EXISTS idx_tickets_deleted_at ON tickets (deleted_at);
+
+CREATE TABLE IF NOT EXISTS tasks (
+    id             bigserial PRIMARY KEY,
+    title          text NOT NULL,
+    description    text,
+    assigned_to_id bigint,
+    project_id     bigint,
+    due_date       timestamptz,
+    status         varchar(20) NOT NULL,
+    priority       varchar(20) DEFAULT 'MEDIUM',
+    created_at     timestamptz,
+    completed_at   timestamptz,
+    deleted_at     timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_tasks_assigned_to_id ON tasks (assigned_to_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_project_id ON tasks (project_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_deleted_at ON tasks (deleted_at);
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id          bigserial PRIMARY KEY,
+    message     text NOT NULL,
+    employee_id bigint,
+    type        varchar(50) DEFAULT 'INFO',
+    created_at  timestamptz,
+    read        boolean NOT NULL DEFAULT false,
+    deleted_at  timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_notifications_employee_id ON notifications (employee_id);
+CREATE INDEX IF NOT EXISTS idx_notifications_deleted_at ON notifications (deleted_at);
+
+CREATE TABLE IF NOT EXISTS team_engineers (
+    team_id     bigint,
+    engineer_id bigint,
+    created_at  timestamptz,
+    PRIMARY KEY (team_id, engineer_id)
+);
+
+CREATE TABLE IF NOT EXISTS project_teams (
+    project_id bigint,
+    team_id    bigint,
+    created_at timestamptz,
+    PRIMARY KEY (project_id, team_id)
+);
+
+CREATE TABLE IF NOT EXISTS project_employees (
+    project_id  bigint,
+    employee_id bigint,
+    role        varchar(50) DEFAULT 'MEMBER',
+    created_at  timestamptz,
+    PRIMARY KEY (project_id, employee_id)
+);
+
+-- Foreign keys are dropped and re-added so existing constraints are replaced rather than duplicated
+ALTER TABLE employees DROP CONSTRAINT IF EXISTS fk_employee_project;
+ALTER TABLE employees ADD CONSTRAINT fk_employee_project
+    FOREIGN KEY (project_assigned_id) REFERENCES projects (id) ON DELETE SET NULL ON UPDATE CASCADE;
+
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS fk_team_leader;
+ALTER TABLE teams ADD CONSTRAINT fk_team_leader
+    FOREIGN KEY (team_leader_id) REFERENCES employees (id) ON DELETE SET NULL ON UPDATE CASCADE;
+
+ALTER TABLE projects DROP CONSTRAINT IF EXISTS fk_project_manager;
+ALTER TABLE projects ADD CONSTRAINT fk_project_manager
+    FOREIGN KEY (manager_id) REFERENCES employees (id) ON DELETE SET NULL ON UPDATE CASCADE;
+
+ALTER TABLE tickets DROP CONSTRAINT IF EXISTS fk_ticket_project;
+ALTER TABLE tickets ADD CONSTRAINT fk_ticket_project
+    FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE tickets DROP CONSTRAINT IF EXISTS fk_ticket_assigned_to;
+ALTER TABLE tickets ADD CONSTRAINT fk_ticket_assigned_to
+    FOREIGN KEY (assigned_to_id) REFERENCES employees (id) ON DELETE SET NULL ON UPDATE CASCADE;
+
+ALTER TABLE tasks DROP CONSTRAINT IF EXISTS fk_task_assigned_to;
+ALTER TABLE tasks ADD CONSTRAINT fk_task_assigned_to
+    FOREIGN KEY (assigned_to_id) REFERENCES employees (id) ON DELETE SET NULL ON UPDATE CASCADE;
+
+ALTER TABLE tasks DROP CONSTRAINT IF EXISTS fk_task_project;
+ALTER TABLE tasks ADD CONSTRAINT fk_task_project
+    FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE SET NULL ON UPDATE CASCADE;
+
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS fk_notification_employee;
+ALTER TABLE notifications ADD CONSTRAINT fk_notification_employee
+    FOREIGN KEY (employee_id) REFERENCES employees (id) ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE team_engineers DROP CONSTRAINT IF EXISTS fk_team_engineer_team;
+ALTER TABLE team_engineers ADD CONSTRAINT fk_team_engineer_team
+    FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE team_engineers DROP CONSTRAINT IF EXISTS fk_team_engineer_engineer;
+ALTER TABLE team_engineers ADD CONSTRAINT fk_team_engineer_engineer
+    FOREIGN KEY (engineer_id) REFERENCES employees (id) ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE project_teams DROP CONSTRAINT IF EXISTS fk_project_team_project;
+ALTER TABLE project_teams ADD CONSTRAINT fk_project_team_project
+    FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE project_teams DROP CONSTRAINT IF EXISTS fk_project_team_team;
+ALTER TABLE project_teams ADD CONSTRAINT fk_project_team_team
+    FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE project_employees DROP CONSTRAINT IF EXISTS fk_project_employee_project;
+ALTER TABLE project_employees ADD CONSTRAINT fk_project_employee_project
+    FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE project_employees DROP CONSTRAINT IF EXISTS fk_project_employee_employee;
+ALTER TABLE project_employees ADD CONSTRAINT fk_project_employee_employee
+    FOREIGN KEY (employee_id) REFERENCES employees (id) ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS sessions (
+    id                 bigserial PRIMARY KEY,
+    employee_id        bigint NOT NULL,
+    refresh_token_hash varchar(64) NOT NULL,
+    expires_at         timestamptz NOT NULL,
+    revoked_at         timestamptz,
+    created_at         timestamptz,
+    updated_at         timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_sessions_employee_id ON sessions (employee_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sessions_refresh_token_hash ON sessions (refresh_token_hash);
+CREATE INDEX IF NOT EXISTS idx_sessions_revoked_at ON sessions (revoked_at);
+
+ALTER TABLE sessions DROP CONSTRAINT IF EXISTS fk_session_employee;
+ALTER TABLE sessions ADD CONSTRAINT fk_session_employee
+    FOREIGN KEY (employee_id) REFERENCES employees (id) ON DELETE CASCADE ON UPDATE CASCADE;
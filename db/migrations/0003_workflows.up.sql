@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS workflows (
+    project_id  bigint PRIMARY KEY,
+    states      jsonb NOT NULL,
+    transitions jsonb NOT NULL,
+    created_at  timestamptz,
+    updated_at  timestamptz
+);
+
+ALTER TABLE workflows DROP CONSTRAINT IF EXISTS fk_workflow_project;
+ALTER TABLE workflows ADD CONSTRAINT fk_workflow_project
+    FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE ON UPDATE CASCADE;
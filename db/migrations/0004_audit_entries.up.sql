@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS audit_entries (
+    id          bigserial PRIMARY KEY,
+    actor_id    bigint,
+    entity_type varchar(50) NOT NULL,
+    entity_id   varchar(64) NOT NULL,
+    operation   varchar(10) NOT NULL,
+    before      jsonb,
+    after       jsonb,
+    request_id  varchar(128),
+    created_at  timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_actor_id ON audit_entries (actor_id);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_entity ON audit_entries (entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_created_at ON audit_entries (created_at);
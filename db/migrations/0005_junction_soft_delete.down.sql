@@ -0,0 +1,8 @@
+-- Soft-deleted memberships would come back to life without the column
+DELETE FROM team_engineers WHERE deleted_at IS NOT NULL;
+DELETE FROM project_teams WHERE deleted_at IS NOT NULL;
+DELETE FROM project_employees WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE team_engineers DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE project_teams DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE project_employees DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,10 @@
+-- Memberships are soft-deleted along with the rows they link, so restoring a project,
+-- team or employee brings them back
+ALTER TABLE team_engineers ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+CREATE INDEX IF NOT EXISTS idx_team_engineers_deleted_at ON team_engineers (deleted_at);
+
+ALTER TABLE project_teams ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+CREATE INDEX IF NOT EXISTS idx_project_teams_deleted_at ON project_teams (deleted_at);
+
+ALTER TABLE project_employees ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+CREATE INDEX IF NOT EXISTS idx_project_employees_deleted_at ON project_employees (deleted_at);
@@ -0,0 +1,50 @@
+CREATE TABLE IF NOT EXISTS comments (
+    id         bigserial PRIMARY KEY,
+    ticket_id  bigint,
+    task_id    bigint,
+    parent_id  bigint,
+    author_id  bigint,
+    body       text NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    edited_at  timestamptz,
+    deleted_at timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_comments_ticket_id ON comments (ticket_id);
+CREATE INDEX IF NOT EXISTS idx_comments_task_id ON comments (task_id);
+CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments (parent_id);
+CREATE INDEX IF NOT EXISTS idx_comments_author_id ON comments (author_id);
+CREATE INDEX IF NOT EXISTS idx_comments_deleted_at ON comments (deleted_at);
+
+-- A comment is on a ticket or on a task, never both
+ALTER TABLE comments DROP CONSTRAINT IF EXISTS chk_comments_target;
+ALTER TABLE comments ADD CONSTRAINT chk_comments_target
+    CHECK ((ticket_id IS NULL) <> (task_id IS NULL));
+
+ALTER TABLE comments DROP CONSTRAINT IF EXISTS fk_comments_ticket;
+ALTER TABLE comments ADD CONSTRAINT fk_comments_ticket
+    FOREIGN KEY (ticket_id) REFERENCES tickets (id) ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE comments DROP CONSTRAINT IF EXISTS fk_comments_task;
+ALTER TABLE comments ADD CONSTRAINT fk_comments_task
+    FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE comments DROP CONSTRAINT IF EXISTS fk_comments_parent;
+ALTER TABLE comments ADD CONSTRAINT fk_comments_parent
+    FOREIGN KEY (parent_id) REFERENCES comments (id) ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE comments DROP CONSTRAINT IF EXISTS fk_comments_author;
+ALTER TABLE comments ADD CONSTRAINT fk_comments_author
+    FOREIGN KEY (author_id) REFERENCES employees (id) ON DELETE SET NULL ON UPDATE CASCADE;
+
+CREATE TABLE IF NOT EXISTS comment_revisions (
+    id         bigserial PRIMARY KEY,
+    comment_id bigint NOT NULL,
+    body       text NOT NULL,
+    created_at timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_comment_revisions_comment_id ON comment_revisions (comment_id);
+
+ALTER TABLE comment_revisions DROP CONSTRAINT IF EXISTS fk_comment_revisions_comment;
+ALTER TABLE comment_revisions ADD CONSTRAINT fk_comment_revisions_comment
+    FOREIGN KEY (comment_id) REFERENCES comments (id) ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1 @@
+-- Hashed passwords cannot be turned back into plaintext; reverting leaves them hashed.
@@ -0,0 +1,3 @@
+-- Hashes the employee passwords still stored in plaintext from before the hashing fix.
+-- bcrypt is not available in SQL, so the migrate subcommand does the work in Go within
+-- this migration's transaction (auth.RehashPlaintextPasswords).
@@ -0,0 +1,391 @@
+package migrations
+
+import (
+	"context"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+var (
+	// ErrSchemaBehind is returned by Verify when migrations are pending
+	ErrSchemaBehind = errors.New("database schema is behind")
+	// ErrChecksumMismatch is returned when an applied migration was edited afterwards
+	ErrChecksumMismatch = errors.New("migration was modified after it was applied")
+	// ErrUnknownVersion is returned when the database has a migration this build does not ship
+	ErrUnknownVersion = errors.New("database has a migration this build does not know")
+)
+
+// lockID keys the advisory lock that keeps concurrent migrate runs apart
+const lockID = 72390113
+
+// Migration is one versioned schema change, read from a <version>_<name>.up.sql and
+// <version>_<name>.down.sql pair
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Checksum fingerprints both directions, so editing an applied migration is detected
+func (m Migration) Checksum() string {
+	sum := sha256.Sum256([]byte(m.Up + "\x00" + m.Down))
+	return hex.EncodeToString(sum[:])
+}
+
+// Record is a row of schema_migrations
+type Record struct {
+	Version   int       `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"not null"`
+	Checksum  string    `gorm:"type:varchar(64);not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+func (Record) TableName() string {
+	return "schema_migrations"
+}
+
+// State describes a migration in Status output
+type State string
+
+const (
+	StatePending  State = "pending"
+	StateApplied  State = "applied"
+	StateModified State = "modified"
+	StateUnknown  State = "unknown"
+)
+
+// Status is one line of `migrate status`
+type Status struct {
+	Version   int
+	Name      string
+	State     State
+	AppliedAt *time.Time
+}
+
+// Step applies or reverts one migration
+type Step struct {
+	Migration Migration
+	Down      bool
+}
+
+var fileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Embedded returns the migrations shipped with this build
+func Embedded() ([]Migration, error) {
+	return Load(files)
+}
+
+// Load reads the migrations in the root of fsys, ordered by version. Every version
+// needs both an up and a down file.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migration file %q must be named <version>_<name>.up.sql or .down.sql", entry.Name())
+		}
+
+		version, _ := strconv.Atoi(match[1])
+		if version <= 0 {
+			return nil, fmt.Errorf("migration file %q: versions start at 1", entry.Name())
+		}
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has two names: %q and %q", version, migration.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both an up and a down file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	slices.SortFunc(migrations, func(a, b Migration) int { return a.Version - b.Version })
+	return migrations, nil
+}
+
+// Statuses lists every known migration with its state, followed by applied versions
+// this build does not ship
+func Statuses(migrations []Migration, applied []Record) []Status {
+	records := make(map[int]Record, len(applied))
+	for _, record := range applied {
+		records[record.Version] = record
+	}
+
+	statuses := make([]Status, 0, len(migrations))
+	for _, migration := range migrations {
+		status := Status{Version: migration.Version, Name: migration.Name, State: StatePending}
+		if record, ok := records[migration.Version]; ok {
+			status.State = StateApplied
+			if record.Checksum != migration.Checksum() {
+				status.State = StateModified
+			}
+			status.AppliedAt = &record.AppliedAt
+			delete(records, migration.Version)
+		}
+		statuses = append(statuses, status)
+	}
+
+	for _, record := range applied {
+		if _, ok := records[record.Version]; ok {
+			statuses = append(statuses, Status{Version: record.Version, Name: record.Name, State: StateUnknown, AppliedAt: &record.AppliedAt})
+		}
+	}
+	return statuses
+}
+
+// Check reports unknown and modified migrations, then pending ones, in that order
+func Check(migrations []Migration, applied []Record) error {
+	pending := 0
+	for _, status := range Statuses(migrations, applied) {
+		switch status.State {
+		case StateUnknown:
+			return fmt.Errorf("%w: version %d (%s)", ErrUnknownVersion, status.Version, status.Name)
+		case StateModified:
+			return fmt.Errorf("%w: version %d (%s)", ErrChecksumMismatch, status.Version, status.Name)
+		case StatePending:
+			pending++
+		}
+	}
+	if pending > 0 {
+		return fmt.Errorf("%w: %d migration(s) pending, run `migrate up`", ErrSchemaBehind, pending)
+	}
+	return nil
+}
+
+// Plan returns the steps that move the schema to target: reverting newer migrations
+// newest first, then applying missing ones oldest first. Target 0 reverts everything.
+func Plan(migrations []Migration, applied []Record, target int) ([]Step, error) {
+	if target != 0 && !slices.ContainsFunc(migrations, func(m Migration) bool { return m.Version == target }) {
+		return nil, fmt.Errorf("unknown migration version %d", target)
+	}
+	if err := Check(migrations, applied); err != nil && !errors.Is(err, ErrSchemaBehind) {
+		return nil, err
+	}
+
+	isApplied := make(map[int]bool, len(applied))
+	for _, record := range applied {
+		isApplied[record.Version] = true
+	}
+
+	var steps []Step
+	for i := len(migrations) - 1; i >= 0; i-- {
+		if migrations[i].Version > target && isApplied[migrations[i].Version] {
+			steps = append(steps, Step{Migration: migrations[i], Down: true})
+		}
+	}
+	for _, migration := range migrations {
+		if migration.Version <= target && !isApplied[migration.Version] {
+			steps = append(steps, Step{Migration: migration})
+		}
+	}
+	return steps, nil
+}
+
+// Migrator runs the embedded migrations against a database
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+	after      map[int]func(tx *gorm.DB) error
+}
+
+// New creates a Migrator for the migrations shipped with this build
+func New(database *gorm.DB) (*Migrator, error) {
+	migrations, err := Embedded()
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: database, migrations: migrations, after: make(map[int]func(tx *gorm.DB) error)}, nil
+}
+
+// After runs fn in the transaction that applies version, once its SQL has run. It is for
+// data changes SQL cannot make, which are then recorded and run only once like the SQL.
+func (m *Migrator) After(version int, fn func(tx *gorm.DB) error) error {
+	if !slices.ContainsFunc(m.migrations, func(migration Migration) bool { return migration.Version == version }) {
+		return fmt.Errorf("unknown migration version %d", version)
+	}
+	m.after[version] = fn
+	return nil
+}
+
+// Latest returns the newest version this build ships
+func (m *Migrator) Latest() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Status reports every migration's state
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(m.db.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return Statuses(m.migrations, applied), nil
+}
+
+// Verify fails unless every migration is applied unmodified and none are unknown
+func (m *Migrator) Verify(ctx context.Context) error {
+	applied, err := m.applied(m.db.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	return Check(m.migrations, applied)
+}
+
+// Up applies every pending migration
+func (m *Migrator) Up(ctx context.Context) ([]Step, error) {
+	return m.To(ctx, m.Latest())
+}
+
+// Down reverts the newest applied migration
+func (m *Migrator) Down(ctx context.Context) ([]Step, error) {
+	var steps []Step
+	err := m.locked(ctx, func(conn *gorm.DB, applied []Record) error {
+		if len(applied) == 0 {
+			return nil
+		}
+
+		// applied is ordered by version, so the target is the version just below the newest
+		target := 0
+		if len(applied) > 1 {
+			target = applied[len(applied)-2].Version
+		}
+		var err error
+		steps, err = m.run(conn, applied, target)
+		return err
+	})
+	return steps, err
+}
+
+// To migrates up or down to version
+func (m *Migrator) To(ctx context.Context, version int) ([]Step, error) {
+	var steps []Step
+	err := m.locked(ctx, func(conn *gorm.DB, applied []Record) error {
+		var err error
+		steps, err = m.run(conn, applied, version)
+		return err
+	})
+	return steps, err
+}
+
+// locked runs fn on one connection holding the migration lock, with the applied
+// migrations read after the lock was taken
+func (m *Migrator) locked(ctx context.Context, fn func(conn *gorm.DB, applied []Record) error) error {
+	return m.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec("SELECT pg_advisory_lock(?)", lockID).Error; err != nil {
+			return fmt.Errorf("failed to take migration lock: %w", err)
+		}
+		defer conn.Exec("SELECT pg_advisory_unlock(?)", lockID)
+
+		if err := conn.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+			version    bigint PRIMARY KEY,
+			name       text NOT NULL,
+			checksum   varchar(64) NOT NULL,
+			applied_at timestamptz NOT NULL
+		)`).Error; err != nil {
+			return fmt.Errorf("failed to create schema_migrations: %w", err)
+		}
+
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+		return fn(conn, applied)
+	})
+}
+
+// run plans and applies the steps to target, each in its own transaction
+func (m *Migrator) run(conn *gorm.DB, applied []Record, target int) ([]Step, error) {
+	steps, err := Plan(m.migrations, applied, target)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, step := range steps {
+		err := conn.Transaction(func(tx *gorm.DB) error {
+			if step.Down {
+				if err := tx.Exec(step.Migration.Down).Error; err != nil {
+					return err
+				}
+				return tx.Delete(&Record{}, step.Migration.Version).Error
+			}
+
+			if err := tx.Exec(step.Migration.Up).Error; err != nil {
+				return err
+			}
+			if after, ok := m.after[step.Migration.Version]; ok {
+				if err := after(tx); err != nil {
+					return err
+				}
+			}
+			return tx.Create(&Record{
+				Version:   step.Migration.Version,
+				Name:      step.Migration.Name,
+				Checksum:  step.Migration.Checksum(),
+				AppliedAt: time.Now(),
+			}).Error
+		})
+		if err != nil {
+			direction := "apply"
+			if step.Down {
+				direction = "revert"
+			}
+			return steps[:i], fmt.Errorf("failed to %s migration %d_%s: %w", direction, step.Migration.Version, step.Migration.Name, err)
+		}
+	}
+	return steps, nil
+}
+
+// applied reads schema_migrations in version order; a missing table means nothing is applied
+func (m *Migrator) applied(conn *gorm.DB) ([]Record, error) {
+	if !conn.Migrator().HasTable(&Record{}) {
+		return nil, nil
+	}
+
+	var records []Record
+	if err := conn.Order("version").Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	return records, nil
+}
@@ -93,22 +93,100 @@ type Notification struct {
 	DeletedAt  gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
-// Junction tables for many-to-many relationships
+// Session is a refresh token issued to an employee at sign in
+type Session struct {
+	ID               int        `gorm:"primaryKey;autoIncrement" json:"id"`
+	EmployeeID       int        `gorm:"not null;index" json:"employee_id"`
+	RefreshTokenHash string     `gorm:"type:varchar(64);uniqueIndex;not null" json:"-"`
+	ExpiresAt        time.Time  `gorm:"not null" json:"expiresAt"`
+	RevokedAt        *time.Time `gorm:"index" json:"revokedAt,omitempty"`
+	CreatedAt        time.Time  `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt        time.Time  `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// Workflow is the status workflow a project defines for its tickets and tasks
+type Workflow struct {
+	ProjectID   int                  `gorm:"primaryKey;autoIncrement:false" json:"project_id"`
+	States      []StatusDB           `gorm:"serializer:json;type:jsonb;not null" json:"states"`
+	Transitions []WorkflowTransition `gorm:"serializer:json;type:jsonb;not null" json:"transitions"`
+	CreatedAt   time.Time            `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt   time.Time            `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// WorkflowTransition allows moving work from one status to another, only for
+// RequiredRole and above when it is set
+type WorkflowTransition struct {
+	From         StatusDB `json:"from"`
+	To           StatusDB `json:"to"`
+	RequiredRole *RoleDB  `json:"required_role,omitempty"`
+}
+
+type AuditOperation string
+
+const (
+	AuditCreate AuditOperation = "CREATE"
+	AuditUpdate AuditOperation = "UPDATE"
+	AuditDelete AuditOperation = "DELETE"
+)
+
+// AuditEntry records who created, changed or deleted a row. Before and After hold only
+// the columns that changed, keyed by column name; a create has no Before and a delete no
+// After. EntityID joins composite keys with ":".
+type AuditEntry struct {
+	ID         int                    `gorm:"primaryKey;autoIncrement" json:"id"`
+	ActorID    *int                   `gorm:"index" json:"actor_id,omitempty"`
+	EntityType string                 `gorm:"type:varchar(50);not null;index:idx_audit_entries_entity" json:"entity_type"`
+	EntityID   string                 `gorm:"type:varchar(64);not null;index:idx_audit_entries_entity" json:"entity_id"`
+	Operation  AuditOperation         `gorm:"type:varchar(10);not null" json:"operation"`
+	Before     map[string]interface{} `gorm:"serializer:json;type:jsonb" json:"before,omitempty"`
+	After      map[string]interface{} `gorm:"serializer:json;type:jsonb" json:"after,omitempty"`
+	RequestID  string                 `gorm:"type:varchar(128)" json:"request_id"`
+	CreatedAt  time.Time              `gorm:"autoCreateTime;index" json:"createdAt"`
+}
+
+// Comment is a Markdown comment on exactly one ticket or task. Replies point at the
+// comment they answer through ParentID and are on the same ticket or task.
+type Comment struct {
+	ID        int            `gorm:"primaryKey;autoIncrement" json:"id"`
+	TicketID  *int           `gorm:"index" json:"ticket_id,omitempty"`
+	TaskID    *int           `gorm:"index" json:"task_id,omitempty"`
+	ParentID  *int           `gorm:"index" json:"parent_id,omitempty"`
+	AuthorID  *int           `gorm:"index" json:"author_id,omitempty"`
+	Body      string         `gorm:"type:text;not null" json:"body"`
+	CreatedAt time.Time      `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime" json:"updatedAt"`
+	EditedAt  *time.Time     `json:"editedAt,omitempty"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+// CommentRevision keeps the body a comment had before an edit
+type CommentRevision struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CommentID int       `gorm:"not null;index" json:"comment_id"`
+	Body      string    `gorm:"type:text;not null" json:"body"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// Junction tables for many-to-many relationships. They are soft-deleted with the rows
+// they link, so restoring either side brings the membership back.
 type TeamEngineer struct {
-	TeamID     int       `gorm:"primaryKey" json:"team_id"`
-	EngineerID int       `gorm:"primaryKey" json:"engineer_id"`
-	CreatedAt  time.Time `gorm:"autoCreateTime" json:"createdAt"`
+	TeamID     int            `gorm:"primaryKey" json:"team_id"`
+	EngineerID int            `gorm:"primaryKey" json:"engineer_id"`
+	CreatedAt  time.Time      `gorm:"autoCreateTime" json:"createdAt"`
+	DeletedAt  gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
 type ProjectTeam struct {
-	ProjectID int       `gorm:"primaryKey" json:"project_id"`
-	TeamID    int       `gorm:"primaryKey" json:"team_id"`
-	CreatedAt time.Time `gorm:"autoCreateTime" json:"createdAt"`
+	ProjectID int            `gorm:"primaryKey" json:"project_id"`
+	TeamID    int            `gorm:"primaryKey" json:"team_id"`
+	CreatedAt time.Time      `gorm:"autoCreateTime" json:"createdAt"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
 type ProjectEmployee struct {
-	ProjectID  int       `gorm:"primaryKey" json:"project_id"`
-	EmployeeID int       `gorm:"primaryKey" json:"employee_id"`
-	Role       string    `gorm:"type:varchar(50);default:'MEMBER'" json:"role"`
-	CreatedAt  time.Time `gorm:"autoCreateTime" json:"createdAt"`
+	ProjectID  int            `gorm:"primaryKey" json:"project_id"`
+	EmployeeID int            `gorm:"primaryKey" json:"employee_id"`
+	Role       string         `gorm:"type:varchar(50);default:'MEMBER'" json:"role"`
+	CreatedAt  time.Time      `gorm:"autoCreateTime" json:"createdAt"`
+	DeletedAt  gorm.DeletedAt `gorm:"index" json:"-"`
 }
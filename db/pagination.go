@@ -0,0 +1,348 @@
+package db
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultPageSize is used when a list query asks for neither first nor last
+	DefaultPageSize = 20
+	// MaxPageSize caps first and last
+	MaxPageSize = 100
+)
+
+// ErrInvalidCursor is returned for after and before values that were not issued for the
+// requested sort order. It matches ErrInvalidInput.
+var ErrInvalidCursor = InvalidInput("invalid cursor")
+
+// Cursor is the keyset position of a row: the names of the sort keys in effect and the
+// row's value for each. Every order ends in (createdAt, id), so positions are unique.
+type Cursor struct {
+	Keys   []string `json:"k"`
+	Values []string `json:"v"`
+}
+
+// Encode returns the opaque string handed to clients
+func (c Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor parses a cursor produced by Cursor.Encode
+func DecodeCursor(value string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(raw, &cursor); err != nil || len(cursor.Keys) == 0 || len(cursor.Keys) != len(cursor.Values) {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return cursor, nil
+}
+
+// PageArgs are the Relay connection arguments of a list query
+type PageArgs struct {
+	First  *int
+	After  *string
+	Last   *int
+	Before *string
+	Sort   []*model.SortInput
+}
+
+// Page is one window of a paginated query
+type Page[T any] struct {
+	Rows       []T
+	Cursors    []string
+	PageInfo   *model.PageInfo
+	TotalCount int
+}
+
+// Paginate runs query as a keyset-paginated list in the order requested by args.Sort,
+// with createdAt and id appended as tie-breakers. totalCount ignores the cursors but
+// honours every other condition already on query.
+func Paginate[T any](query *gorm.DB, sorts Sorts[T], args PageArgs) (*Page[T], error) {
+	order, limit, err := prepare(sorts, args)
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count rows: %w", err)
+	}
+
+	window := query.Session(&gorm.Session{})
+	if args.After != nil {
+		if window, err = order.seek(window, *args.After, false); err != nil {
+			return nil, err
+		}
+	}
+	if args.Before != nil {
+		if window, err = order.seek(window, *args.Before, true); err != nil {
+			return nil, err
+		}
+	}
+
+	// Paging backwards reads the window in reverse and flips it afterwards
+	window = window.Order(order.clause(args.Last != nil)).Limit(limit + 1)
+
+	var rows []T
+	if err := window.Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	return order.page(rows, limit, args, int(total)), nil
+}
+
+// PaginateSlice applies the same ordering, cursors and window as Paginate to rows that
+// are already in memory
+func PaginateSlice[T any](rows []T, sorts Sorts[T], args PageArgs) (*Page[T], error) {
+	order, limit, err := prepare(sorts, args)
+	if err != nil {
+		return nil, err
+	}
+
+	var after, before *Cursor
+	if args.After != nil {
+		if after, err = order.decode(*args.After); err != nil {
+			return nil, err
+		}
+	}
+	if args.Before != nil {
+		if before, err = order.decode(*args.Before); err != nil {
+			return nil, err
+		}
+	}
+
+	backward := args.Last != nil
+	window := make([]T, 0, len(rows))
+	for _, row := range rows {
+		if after != nil && order.compareToCursor(row, after) <= 0 {
+			continue
+		}
+		if before != nil && order.compareToCursor(row, before) >= 0 {
+			continue
+		}
+		window = append(window, row)
+	}
+
+	slices.SortStableFunc(window, func(a, b T) int {
+		if backward {
+			return order.compare(b, a)
+		}
+		return order.compare(a, b)
+	})
+	if len(window) > limit+1 {
+		window = window[:limit+1]
+	}
+
+	return order.page(window, limit, args, len(rows)), nil
+}
+
+func prepare[T any](sorts Sorts[T], args PageArgs) (sortOrder[T], int, error) {
+	if args.First != nil && args.Last != nil {
+		return nil, 0, InvalidInput("first and last cannot be combined")
+	}
+
+	limit, err := pageSize(args)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	order, err := sorts.order(args.Sort)
+	if err != nil {
+		return nil, 0, err
+	}
+	return order, limit, nil
+}
+
+// page trims the limit+1 rows read in window order to the requested page
+func (o sortOrder[T]) page(rows []T, limit int, args PageArgs, total int) *Page[T] {
+	backward := args.Last != nil
+
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+	if backward {
+		slices.Reverse(rows)
+	}
+
+	cursors := make([]string, len(rows))
+	for i, row := range rows {
+		cursors[i] = o.cursor(row).Encode()
+	}
+
+	pageInfo := &model.PageInfo{
+		HasNextPage:     hasMore,
+		HasPreviousPage: args.After != nil,
+	}
+	if backward {
+		pageInfo.HasNextPage = args.Before != nil
+		pageInfo.HasPreviousPage = hasMore
+	}
+	if len(rows) > 0 {
+		pageInfo.StartCursor = &cursors[0]
+		pageInfo.EndCursor = &cursors[len(cursors)-1]
+	}
+
+	return &Page[T]{Rows: rows, Cursors: cursors, PageInfo: pageInfo, TotalCount: total}
+}
+
+func pageSize(args PageArgs) (int, error) {
+	size := args.First
+	name := "first"
+	if args.Last != nil {
+		size = args.Last
+		name = "last"
+	}
+	if size == nil {
+		return DefaultPageSize, nil
+	}
+	if *size < 0 || *size > MaxPageSize {
+		return 0, InvalidInput("%s must be between 0 and %d", name, MaxPageSize)
+	}
+	return *size, nil
+}
+
+type orderKey[T any] struct {
+	name string
+	key  SortKey[T]
+	desc bool
+}
+
+type sortOrder[T any] []orderKey[T]
+
+func (o sortOrder[T]) clause(reverse bool) string {
+	terms := make([]string, len(o))
+	for i, k := range o {
+		direction := "ASC"
+		if k.desc != reverse {
+			direction = "DESC"
+		}
+		terms[i] = k.key.Column + " " + direction
+	}
+	return strings.Join(terms, ", ")
+}
+
+func (o sortOrder[T]) cursor(row T) Cursor {
+	cursor := Cursor{Keys: make([]string, len(o)), Values: make([]string, len(o))}
+	for i, k := range o {
+		cursor.Keys[i] = k.name
+		cursor.Values[i] = encodeSortValue(k.key.Value(row))
+	}
+	return cursor
+}
+
+// seek restricts query to rows after the cursor in this order, or before it
+func (o sortOrder[T]) seek(query *gorm.DB, value string, before bool) (*gorm.DB, error) {
+	cursor, err := o.decode(value)
+	if err != nil {
+		return nil, err
+	}
+
+	operator := func(k orderKey[T]) string {
+		if k.desc != before {
+			return "<"
+		}
+		return ">"
+	}
+
+	values := make([]interface{}, len(cursor.Values))
+	for i, v := range cursor.Values {
+		values[i] = v
+	}
+
+	// A row comparison serves orders that run one way; mixed directions need the
+	// expanded (a > x) OR (a = x AND b > y) ... form
+	if o.uniform() {
+		columns := make([]string, len(o))
+		for i, k := range o {
+			columns[i] = k.key.Column
+		}
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(o)), ", ")
+		condition := fmt.Sprintf("(%s) %s (%s)", strings.Join(columns, ", "), operator(o[0]), placeholders)
+		return query.Where(condition, values...), nil
+	}
+
+	var branches []string
+	var vars []interface{}
+	for i := range o {
+		var terms []string
+		for j := 0; j < i; j++ {
+			terms = append(terms, o[j].key.Column+" = ?")
+			vars = append(vars, values[j])
+		}
+		terms = append(terms, o[i].key.Column+" "+operator(o[i])+" ?")
+		vars = append(vars, values[i])
+		branches = append(branches, "("+strings.Join(terms, " AND ")+")")
+	}
+	return query.Where("("+strings.Join(branches, " OR ")+")", vars...), nil
+}
+
+// decode parses a cursor and checks it was issued for this order
+func (o sortOrder[T]) decode(value string) (*Cursor, error) {
+	cursor, err := DecodeCursor(value)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Equal(cursor.Keys, o.names()) {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor, nil
+}
+
+// compare orders two rows the way the ORDER BY clause does
+func (o sortOrder[T]) compare(a, b T) int {
+	for _, k := range o {
+		c := compareSortValues(k.key.Value(a), k.key.Value(b))
+		if k.desc {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// compareToCursor orders a row against a cursor position
+func (o sortOrder[T]) compareToCursor(row T, cursor *Cursor) int {
+	for i, k := range o {
+		value := k.key.Value(row)
+		c := compareSortValues(value, decodeSortValue(value, cursor.Values[i]))
+		if k.desc {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func (o sortOrder[T]) names() []string {
+	names := make([]string, len(o))
+	for i, k := range o {
+		names[i] = k.name
+	}
+	return names
+}
+
+func (o sortOrder[T]) uniform() bool {
+	for _, k := range o {
+		if k.desc != o[0].desc {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,225 @@
+package db
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+)
+
+// SortKey is a whitelisted ORDER BY expression. Column is trusted SQL and must never be
+// built from client input; Value returns a row's value of the expression as a string,
+// int, bool, time.Time or *time.Time, which is what cursors and in-memory sorting use.
+type SortKey[T any] struct {
+	Column string
+	Value  func(T) any
+}
+
+// Sorts maps the field names clients may pass in SortInput to their sort keys. Every
+// Sorts must define createdAt and id, which break ties in every order.
+type Sorts[T any] map[string]SortKey[T]
+
+// order resolves the requested sort into keys, rejecting unknown and repeated fields
+func (s Sorts[T]) order(sort []*model.SortInput) (sortOrder[T], error) {
+	var order sortOrder[T]
+	seen := map[string]bool{}
+	for _, input := range sort {
+		key, ok := s[input.Field]
+		if !ok {
+			return nil, InvalidInput("cannot sort by %q: sortable fields are %s", input.Field, strings.Join(s.fields(), ", "))
+		}
+		if seen[input.Field] {
+			return nil, InvalidInput("cannot sort by %q more than once", input.Field)
+		}
+		seen[input.Field] = true
+		order = append(order, orderKey[T]{name: input.Field, key: key, desc: input.Direction == model.SortDirectionDesc})
+	}
+
+	// Tie-breakers follow the direction of the last requested field
+	desc := len(order) > 0 && order[len(order)-1].desc
+	for _, name := range []string{"createdAt", "id"} {
+		if !seen[name] {
+			order = append(order, orderKey[T]{name: name, key: s[name], desc: desc})
+		}
+	}
+	return order, nil
+}
+
+func (s Sorts[T]) fields() []string {
+	fields := make([]string, 0, len(s))
+	for field := range s {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+	return fields
+}
+
+// priorityRank orders priorities by urgency rather than alphabetically
+func priorityRank(column string) string {
+	return fmt.Sprintf("CASE %s WHEN 'URGENT' THEN 4 WHEN 'HIGH' THEN 3 WHEN 'MEDIUM' THEN 2 ELSE 1 END", column)
+}
+
+func rankOfPriority(priority string) any {
+	switch model.Priority(priority) {
+	case model.PriorityUrgent:
+		return 4
+	case model.PriorityHigh:
+		return 3
+	case model.PriorityMedium:
+		return 2
+	}
+	return 1
+}
+
+// nullsLast sorts missing dates after every real one in ascending order
+func nullsLast(column string) string {
+	return fmt.Sprintf("COALESCE(%s, 'infinity')", column)
+}
+
+// encodeSortValue renders a sort value in a form Postgres parses back into the column type
+func encodeSortValue(value any) string {
+	switch v := value.(type) {
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	case *time.Time:
+		if v == nil {
+			return "infinity"
+		}
+		return v.UTC().Format(time.RFC3339Nano)
+	case int:
+		return strconv.Itoa(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return v
+	}
+	return fmt.Sprint(value)
+}
+
+// decodeSortValue parses an encoded value back into the type of like; unparsable values
+// decode to the zero value, which only misplaces a tampered cursor
+func decodeSortValue(like any, encoded string) any {
+	switch like.(type) {
+	case time.Time:
+		t, _ := time.Parse(time.RFC3339Nano, encoded)
+		return t
+	case *time.Time:
+		if encoded == "infinity" {
+			return (*time.Time)(nil)
+		}
+		t, _ := time.Parse(time.RFC3339Nano, encoded)
+		return &t
+	case int:
+		n, _ := strconv.Atoi(encoded)
+		return n
+	case bool:
+		b, _ := strconv.ParseBool(encoded)
+		return b
+	}
+	return encoded
+}
+
+// compareSortValues compares two values of the same sort key; a nil *time.Time sorts
+// last, like the infinity it stands for in SQL
+func compareSortValues(a, b any) int {
+	switch x := a.(type) {
+	case time.Time:
+		return x.Compare(b.(time.Time))
+	case *time.Time:
+		y := b.(*time.Time)
+		switch {
+		case x == nil && y == nil:
+			return 0
+		case x == nil:
+			return 1
+		case y == nil:
+			return -1
+		}
+		return x.Compare(*y)
+	case int:
+		return cmp.Compare(x, b.(int))
+	case bool:
+		y := b.(bool)
+		switch {
+		case x == y:
+			return 0
+		case !x:
+			return -1
+		}
+		return 1
+	case string:
+		return strings.Compare(x, b.(string))
+	}
+	return 0
+}
+
+// EmployeeSorts are the sortable employee fields
+var EmployeeSorts = Sorts[Employee]{
+	"name":      {"employees.name", func(e Employee) any { return e.Name }},
+	"email":     {"employees.email", func(e Employee) any { return e.Email }},
+	"role":      {"employees.role", func(e Employee) any { return string(e.Role) }},
+	"createdAt": {"employees.created_at", func(e Employee) any { return e.CreatedAt }},
+	"id":        {"employees.id", func(e Employee) any { return e.ID }},
+}
+
+// ProjectSorts are the sortable project fields
+var ProjectSorts = Sorts[Project]{
+	"name":      {"projects.name", func(p Project) any { return p.Name }},
+	"status":    {"projects.status", func(p Project) any { return string(p.Status) }},
+	"startDate": {"projects.start_date", func(p Project) any { return p.StartDate }},
+	"createdAt": {"projects.created_at", func(p Project) any { return p.CreatedAt }},
+	"id":        {"projects.id", func(p Project) any { return p.ID }},
+}
+
+// TaskSorts are the sortable task fields
+var TaskSorts = Sorts[Task]{
+	"title":     {"tasks.title", func(t Task) any { return t.Title }},
+	"status":    {"tasks.status", func(t Task) any { return string(t.Status) }},
+	"priority":  {priorityRank("tasks.priority"), func(t Task) any { return rankOfPriority(t.Priority) }},
+	"dueDate":   {nullsLast("tasks.due_date"), func(t Task) any { return t.DueDate }},
+	"createdAt": {"tasks.created_at", func(t Task) any { return t.CreatedAt }},
+	"id":        {"tasks.id", func(t Task) any { return t.ID }},
+}
+
+// TicketSorts are the sortable ticket fields
+var TicketSorts = Sorts[Ticket]{
+	"title":     {"tickets.title", func(t Ticket) any { return t.Title }},
+	"status":    {"tickets.status", func(t Ticket) any { return string(t.Status) }},
+	"priority":  {priorityRank("tickets.priority"), func(t Ticket) any { return rankOfPriority(t.Priority) }},
+	"createdAt": {"tickets.created_at", func(t Ticket) any { return t.CreatedAt }},
+	"id":        {"tickets.id", func(t Ticket) any { return t.ID }},
+}
+
+// TeamSorts are the sortable team fields
+var TeamSorts = Sorts[Team]{
+	"name":      {"teams.name", func(t Team) any { return t.Name }},
+	"createdAt": {"teams.created_at", func(t Team) any { return t.CreatedAt }},
+	"id":        {"teams.id", func(t Team) any { return t.ID }},
+}
+
+// NotificationSorts are the sortable notification fields
+var NotificationSorts = Sorts[Notification]{
+	"type":      {"notifications.type", func(n Notification) any { return n.Type }},
+	"read":      {"notifications.read", func(n Notification) any { return n.Read }},
+	"createdAt": {"notifications.created_at", func(n Notification) any { return n.CreatedAt }},
+	"id":        {"notifications.id", func(n Notification) any { return n.ID }},
+}
+
+// AuditEntrySorts are the sortable audit log fields
+var AuditEntrySorts = Sorts[AuditEntry]{
+	"entityType": {"audit_entries.entity_type", func(e AuditEntry) any { return e.EntityType }},
+	"operation":  {"audit_entries.operation", func(e AuditEntry) any { return string(e.Operation) }},
+	"createdAt":  {"audit_entries.created_at", func(e AuditEntry) any { return e.CreatedAt }},
+	"id":         {"audit_entries.id", func(e AuditEntry) any { return e.ID }},
+}
+
+// CommentSorts are the sortable comment fields
+var CommentSorts = Sorts[Comment]{
+	"updatedAt": {"comments.updated_at", func(c Comment) any { return c.UpdatedAt }},
+	"createdAt": {"comments.created_at", func(c Comment) any { return c.CreatedAt }},
+	"id":        {"comments.id", func(c Comment) any { return c.ID }},
+}
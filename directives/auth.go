@@ -5,19 +5,39 @@ import (
 	"fmt"
 
 	"github.com/99designs/gqlgen/graphql"
-	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"github.com/JonJenson-MFIn/project-management-system-api/auth"
 	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"github.com/JonJenson-MFIn/project-management-system-api/policy"
 )
 
-func AuthDirective(ctx context.Context, obj interface{} , next graphql.Resolver, role model.Role) (interface{}, error) {
+// AuthDirective implements @auth. `role` admits that role and every role above it in the
+// hierarchy; `roles` admits only the listed roles, plus ADMIN which is always allowed.
+func AuthDirective(ctx context.Context, obj interface{}, next graphql.Resolver, role *model.Role, roles []model.Role) (interface{}, error) {
 	user := middleware.GetUserFromContext(ctx)
 	if user == nil {
-		return nil, fmt.Errorf("unauthenticated")
+		return nil, auth.ErrUnauthenticated
+	}
+
+	if role != nil && !user.Role.AtLeast(*role) {
+		return nil, fmt.Errorf("%w: requires %s or higher, got %s", policy.ErrForbidden, *role, user.Role)
 	}
 
-	if user.Role != role {
-		return nil, fmt.Errorf("forbidden: requires %s, got %s", role, user.Role)
+	if len(roles) > 0 && !hasAnyRole(user.Role, roles) {
+		return nil, fmt.Errorf("%w: requires one of %v, got %s", policy.ErrForbidden, roles, user.Role)
 	}
 
 	return next(ctx)
 }
+
+func hasAnyRole(userRole model.Role, roles []model.Role) bool {
+	if userRole == model.RoleAdmin {
+		return true
+	}
+	for _, r := range roles {
+		if userRole == r {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package directives
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/JonJenson-MFIn/project-management-system-api/cache"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+)
+
+// typeTables maps GraphQL object types to the tables their rows come from
+var typeTables = map[string][]string{
+	"Employee":        {"employees"},
+	"Project":         {"projects"},
+	"Team":            {"teams"},
+	"Ticket":          {"tickets"},
+	"Task":            {"tasks"},
+	"Notification":    {"notifications"},
+	"TeamEngineer":    {"team_engineers"},
+	"ProjectTeam":     {"project_teams"},
+	"ProjectEmployee": {"project_employees"},
+}
+
+// fieldTables lists the extra tables a field reads beyond those of its return type
+var fieldTables = map[string][]string{
+	"Query.teamsByProject":  {"project_teams"},
+	"Query.engineersByTeam": {"team_engineers"},
+}
+
+// ResponseCache implements @cache(ttl) on top of a pluggable backend. Entries are keyed
+// by field, arguments and caller role and are dropped when their tables are written.
+type ResponseCache struct {
+	Backend cache.Backend
+}
+
+// NewResponseCache creates a ResponseCache
+func NewResponseCache(backend cache.Backend) *ResponseCache {
+	return &ResponseCache{Backend: backend}
+}
+
+// Directive is the @cache(ttl) implementation; ttl is in seconds
+func (c *ResponseCache) Directive(ctx context.Context, obj interface{}, next graphql.Resolver, ttl int) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || ttl <= 0 {
+		return next(ctx)
+	}
+
+	key, err := cacheKey(ctx, fc)
+	if err != nil {
+		return next(ctx)
+	}
+
+	if value, ok := c.Backend.Get(key); ok {
+		return value, nil
+	}
+
+	value, err := next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Backend.Set(key, value, time.Duration(ttl)*time.Second, fieldTags(fc))
+	return value, nil
+}
+
+func cacheKey(ctx context.Context, fc *graphql.FieldContext) (string, error) {
+	args, err := json.Marshal(fc.Args)
+	if err != nil {
+		return "", err
+	}
+
+	role := "ANONYMOUS"
+	if user := middleware.GetUserFromContext(ctx); user != nil {
+		role = string(user.Role)
+	}
+
+	return fmt.Sprintf("%s.%s|%s|%s", fc.Object, fc.Field.Name, role, args), nil
+}
+
+func fieldTags(fc *graphql.FieldContext) []string {
+	var tags []string
+	if fc.Field.Definition != nil {
+		// Connections are tagged with the tables of the type they page over
+		name := strings.TrimSuffix(fc.Field.Definition.Type.Name(), "Connection")
+		tags = append(tags, typeTables[name]...)
+	}
+	return append(tags, fieldTables[fc.Object+"."+fc.Field.Name]...)
+}
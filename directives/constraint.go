@@ -0,0 +1,248 @@
+package directives
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Formats accepted by @constraint(format), with the message for values that break them
+var formats = map[string]struct {
+	valid   func(string) bool
+	message string
+}{
+	"email": {
+		valid: func(s string) bool {
+			address, err := mail.ParseAddress(s)
+			return err == nil && address.Name == "" && address.Address == s
+		},
+		message: "must be a valid email address",
+	},
+	"date": {
+		valid: func(s string) bool {
+			_, err := time.Parse("2006-01-02", s)
+			return err == nil
+		},
+		message: "must be a date in YYYY-MM-DD format",
+	},
+}
+
+// constraint is a parsed @constraint
+type constraint struct {
+	notNull   bool
+	minLength *int
+	maxLength *int
+	min       *int
+	format    string
+	oneOf     []string
+}
+
+// Constraints implements @constraint. It runs as a server extension rather than a
+// directive function so that it sees all arguments of a field together and reports every
+// violation, not just the first; the resolver is skipped when there are any. Add it to
+// the server with Use.
+type Constraints struct {
+	schema *ast.Schema
+	rules  map[*ast.Directive]constraint
+}
+
+var (
+	_ graphql.HandlerExtension = &Constraints{}
+	_ graphql.FieldInterceptor = &Constraints{}
+)
+
+func (c *Constraints) ExtensionName() string {
+	return "Constraints"
+}
+
+// Validate parses every @constraint in the schema, rejecting unknown formats at startup
+func (c *Constraints) Validate(schema graphql.ExecutableSchema) error {
+	c.schema = schema.Schema()
+	c.rules = make(map[*ast.Directive]constraint)
+
+	parse := func(owner string, directives ast.DirectiveList) error {
+		directive := directives.ForName("constraint")
+		if directive == nil {
+			return nil
+		}
+		rule, err := parseConstraint(directive)
+		if err != nil {
+			return fmt.Errorf("@constraint on %s: %w", owner, err)
+		}
+		c.rules[directive] = rule
+		return nil
+	}
+
+	for _, def := range c.schema.Types {
+		for _, field := range def.Fields {
+			if err := parse(def.Name+"."+field.Name, field.Directives); err != nil {
+				return err
+			}
+			for _, arg := range field.Arguments {
+				if err := parse(def.Name+"."+field.Name+"("+arg.Name+")", arg.Directives); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func parseConstraint(directive *ast.Directive) (constraint, error) {
+	var rule constraint
+	for _, arg := range directive.Arguments {
+		value, err := arg.Value.Value(nil)
+		if err != nil {
+			return rule, err
+		}
+		switch arg.Name {
+		case "notNull":
+			rule.notNull, _ = value.(bool)
+		case "minLength":
+			rule.minLength = asInt(value)
+		case "maxLength":
+			rule.maxLength = asInt(value)
+		case "min":
+			rule.min = asInt(value)
+		case "format":
+			rule.format, _ = value.(string)
+			if _, ok := formats[rule.format]; !ok {
+				return rule, fmt.Errorf("unknown format %q", rule.format)
+			}
+		case "oneOf":
+			for _, item := range graphql.CoerceList(value) {
+				s, _ := item.(string)
+				rule.oneOf = append(rule.oneOf, s)
+			}
+		}
+	}
+	return rule, nil
+}
+
+// InterceptField checks the arguments of resolver fields before they run
+func (c *Constraints) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver || fc.Field.Definition == nil || len(fc.Field.Definition.Arguments) == 0 {
+		return next(ctx)
+	}
+
+	values := fc.Field.ArgumentMap(graphql.GetOperationContext(ctx).Variables)
+	var violations []error
+	for _, arg := range fc.Field.Definition.Arguments {
+		value, present := values[arg.Name]
+		violations = c.check(violations, arg.Name, arg.Type, arg.Directives, value, present)
+	}
+	if len(violations) == 0 {
+		return next(ctx)
+	}
+
+	// Each violation is its own error; having errors on the field also keeps gqlgen from
+	// adding a "must not be null" one for the missing result
+	for _, violation := range violations {
+		graphql.AddError(ctx, violation)
+	}
+	return nil, nil
+}
+
+// check appends the violations of value and, for input objects, of its fields. present
+// tells an explicit null from an omitted value.
+func (c *Constraints) check(violations []error, path string, typ *ast.Type, directives ast.DirectiveList, value interface{}, present bool) []error {
+	var rule constraint
+	if directive := directives.ForName("constraint"); directive != nil {
+		rule = c.rules[directive]
+	}
+	violation := func(message string) {
+		violations = append(violations, &gqlerror.Error{
+			Message:    path + " " + message,
+			Err:        db.InvalidInput("%s %s", path, message),
+			Extensions: map[string]interface{}{"field": path},
+		})
+	}
+
+	if value == nil {
+		if present && rule.notNull {
+			violation("must not be null")
+		}
+		return violations
+	}
+
+	if typ.Elem != nil {
+		for i, item := range graphql.CoerceList(value) {
+			violations = c.check(violations, fmt.Sprintf("%s[%d]", path, i), typ.Elem, directives, item, true)
+		}
+		return violations
+	}
+
+	for _, message := range rule.violations(value) {
+		violation(message)
+	}
+
+	if def := c.schema.Types[typ.NamedType]; def != nil && def.Kind == ast.InputObject {
+		fields, _ := value.(map[string]interface{})
+		for _, field := range def.Fields {
+			value, present := fields[field.Name]
+			violations = c.check(violations, path+"."+field.Name, field.Type, field.Directives, value, present)
+		}
+	}
+	return violations
+}
+
+// violations describes every rule value breaks
+func (rule constraint) violations(value interface{}) []string {
+	var messages []string
+	if s, ok := value.(string); ok {
+		if rule.minLength != nil && utf8.RuneCountInString(strings.TrimSpace(s)) < *rule.minLength {
+			if *rule.minLength == 1 {
+				messages = append(messages, "must not be blank")
+			} else {
+				messages = append(messages, fmt.Sprintf("must be at least %d characters", *rule.minLength))
+			}
+		}
+		if rule.maxLength != nil && utf8.RuneCountInString(s) > *rule.maxLength {
+			messages = append(messages, fmt.Sprintf("must be at most %d characters", *rule.maxLength))
+		}
+		if format, ok := formats[rule.format]; ok && !format.valid(s) {
+			messages = append(messages, format.message)
+		}
+		if len(rule.oneOf) > 0 && !slices.Contains(rule.oneOf, s) {
+			messages = append(messages, "must be one of "+strings.Join(rule.oneOf, ", "))
+		}
+	}
+	if n := asInt(value); n != nil && rule.min != nil && *n < *rule.min {
+		messages = append(messages, fmt.Sprintf("must be at least %d", *rule.min))
+	}
+	return messages
+}
+
+// asInt reads an Int from a schema literal or a request, where JSON numbers may arrive
+// in several forms
+func asInt(value interface{}) *int {
+	var n int
+	switch v := value.(type) {
+	case int:
+		n = v
+	case int64:
+		n = int(v)
+	case float64:
+		n = int(v)
+	case json.Number:
+		i, err := v.Int64()
+		if err != nil {
+			return nil
+		}
+		n = int(i)
+	default:
+		return nil
+	}
+	return &n
+}
@@ -0,0 +1,144 @@
+package directives
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Idle buckets are dropped once they have been full for this long
+const bucketIdleTimeout = 10 * time.Minute
+
+// RateLimiter implements @rateLimit with an in-process token bucket per field and caller.
+// Authenticated callers are keyed by employee ID, anonymous ones by client IP.
+type RateLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	windows   map[string]time.Duration
+	lastSweep time.Time
+}
+
+type tokenBucket struct {
+	tokens   float64
+	lastSeen time.Time
+}
+
+// NewRateLimiter creates an empty RateLimiter
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		buckets:   make(map[string]*tokenBucket),
+		windows:   make(map[string]time.Duration),
+		lastSweep: time.Now(),
+	}
+}
+
+// Directive is the @rateLimit(limit, window) implementation. `limit` calls are allowed per
+// `window`, refilling continuously, so bursts of up to `limit` calls are possible.
+func (l *RateLimiter) Directive(ctx context.Context, obj interface{}, next graphql.Resolver, limit int, window string) (interface{}, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid rate limit %d", limit)
+	}
+
+	period, err := l.parseWindow(window)
+	if err != nil {
+		return nil, err
+	}
+
+	key := fieldKey(ctx) + "|" + callerKey(ctx)
+	retryAfter, ok := l.take(key, limit, period, time.Now())
+	if !ok {
+		seconds := int(math.Ceil(retryAfter.Seconds()))
+		return nil, &gqlerror.Error{
+			Path:    graphql.GetPath(ctx),
+			Message: fmt.Sprintf("rate limit exceeded: %d requests per %s, retry in %ds", limit, window, seconds),
+			Extensions: map[string]interface{}{
+				"code":       "RATE_LIMITED",
+				"limit":      limit,
+				"window":     window,
+				"retryAfter": seconds,
+			},
+		}
+	}
+
+	return next(ctx)
+}
+
+// take consumes one token from the bucket, returning how long to wait when it is empty
+func (l *RateLimiter) take(key string, limit int, period time.Duration, now time.Time) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	capacity := float64(limit)
+	rate := capacity / period.Seconds()
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: capacity, lastSeen: now}
+		l.buckets[key] = bucket
+	}
+
+	elapsed := now.Sub(bucket.lastSeen).Seconds()
+	bucket.tokens = math.Min(capacity, bucket.tokens+elapsed*rate)
+	bucket.lastSeen = now
+
+	if bucket.tokens < 1 {
+		wait := (1 - bucket.tokens) / rate
+		return time.Duration(wait * float64(time.Second)), false
+	}
+
+	bucket.tokens--
+	return 0, true
+}
+
+// sweep drops buckets that have not been touched recently so the map does not grow forever
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < time.Minute {
+		return
+	}
+	for key, bucket := range l.buckets {
+		if now.Sub(bucket.lastSeen) > bucketIdleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+func (l *RateLimiter) parseWindow(window string) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if period, ok := l.windows[window]; ok {
+		return period, nil
+	}
+
+	period, err := time.ParseDuration(window)
+	if err != nil || period <= 0 {
+		return 0, fmt.Errorf("invalid rate limit window %q", window)
+	}
+	l.windows[window] = period
+	return period, nil
+}
+
+func fieldKey(ctx context.Context) string {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return ""
+	}
+	return fc.Object + "." + fc.Field.Name
+}
+
+func callerKey(ctx context.Context) string {
+	if user := middleware.GetUserFromContext(ctx); user != nil {
+		return "employee:" + strconv.Itoa(user.ID)
+	}
+	return "ip:" + middleware.GetClientIPFromContext(ctx)
+}
@@ -33,29 +33,114 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	AuditEntry() AuditEntryResolver
+	Comment() CommentResolver
+	Employee() EmployeeResolver
 	Mutation() MutationResolver
+	Project() ProjectResolver
 	Query() QueryResolver
+	Task() TaskResolver
+	Team() TeamResolver
+	Ticket() TicketResolver
 }
 
 type DirectiveRoot struct {
-	Auth      func(ctx context.Context, obj any, next graphql.Resolver, role model.Role) (res any, err error)
+	Auth      func(ctx context.Context, obj any, next graphql.Resolver, role *model.Role, roles []model.Role) (res any, err error)
 	Cache     func(ctx context.Context, obj any, next graphql.Resolver, ttl int) (res any, err error)
 	RateLimit func(ctx context.Context, obj any, next graphql.Resolver, limit int, window string) (res any, err error)
 }
 
 type ComplexityRoot struct {
+	AuditEntry struct {
+		Actor      func(childComplexity int) int
+		ActorID    func(childComplexity int) int
+		After      func(childComplexity int) int
+		Before     func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		EntityID   func(childComplexity int) int
+		EntityType func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Operation  func(childComplexity int) int
+		RequestID  func(childComplexity int) int
+	}
+
+	AuditEntryConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	AuditEntryEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	AuthPayload struct {
+		AccessToken           func(childComplexity int) int
+		Employee              func(childComplexity int) int
+		ExpiresAt             func(childComplexity int) int
+		RefreshToken          func(childComplexity int) int
+		RefreshTokenExpiresAt func(childComplexity int) int
+	}
+
+	Comment struct {
+		Author    func(childComplexity int) int
+		AuthorID  func(childComplexity int) int
+		Body      func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		EditedAt  func(childComplexity int) int
+		ID        func(childComplexity int) int
+		ParentID  func(childComplexity int) int
+		Replies   func(childComplexity int) int
+		Revisions func(childComplexity int) int
+		TaskID    func(childComplexity int) int
+		TicketID  func(childComplexity int) int
+		UpdatedAt func(childComplexity int) int
+	}
+
+	CommentConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CommentEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	CommentRevision struct {
+		Body      func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+	}
+
 	Employee struct {
 		Active            func(childComplexity int) int
 		CreatedAt         func(childComplexity int) int
+		DeletedAt         func(childComplexity int) int
 		Email             func(childComplexity int) int
 		ID                func(childComplexity int) int
 		Name              func(childComplexity int) int
 		ProjectAssignedID func(childComplexity int) int
+		Projects          func(childComplexity int) int
 		Role              func(childComplexity int) int
 		UpdatedAt         func(childComplexity int) int
 	}
 
+	EmployeeConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	EmployeeEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	Mutation struct {
+		AddComment                func(childComplexity int, input model.CommentInput) int
 		AddEmployee               func(childComplexity int, input model.EmployeeInput) int
 		AddNotification           func(childComplexity int, message string, employeeID int, typeArg *model.NotificationType) int
 		AddProject                func(childComplexity int, input model.ProjectInput) int
@@ -65,21 +150,36 @@ type ComplexityRoot struct {
 		AddTeam                   func(childComplexity int, input model.TeamInput) int
 		AddTeamEngineer           func(childComplexity int, input model.TeamEngineerInput) int
 		AddTicket                 func(childComplexity int, input model.TicketInput) int
+		ChangePassword            func(childComplexity int, oldPassword string, newPassword string) int
+		DeleteComment             func(childComplexity int, id int) int
 		DeleteEmployee            func(childComplexity int, id int) int
 		DeleteProject             func(childComplexity int, id int) int
 		DeleteTask                func(childComplexity int, id int) int
 		DeleteTeam                func(childComplexity int, id int) int
 		DeleteTicket              func(childComplexity int, id int) int
+		EditComment               func(childComplexity int, id int, body string) int
 		MarkNotificationRead      func(childComplexity int, id int) int
+		PurgeTrash                func(childComplexity int, olderThanDays *int) int
+		RefreshSession            func(childComplexity int, refreshToken string) int
 		RemoveProjectEmployee     func(childComplexity int, input model.ProjectEmployeeInput) int
 		RemoveProjectTeam         func(childComplexity int, input model.ProjectTeamInput) int
 		RemoveTeamEngineer        func(childComplexity int, input model.TeamEngineerInput) int
-		UpdateEmployee            func(childComplexity int, id int, input model.EmployeeInput) int
-		UpdateProject             func(childComplexity int, id int, input model.ProjectInput) int
+		ResetWorkflow             func(childComplexity int, projectID int) int
+		RestoreEmployee           func(childComplexity int, id int) int
+		RestoreProject            func(childComplexity int, id int) int
+		RestoreTask               func(childComplexity int, id int) int
+		RestoreTeam               func(childComplexity int, id int) int
+		RestoreTicket             func(childComplexity int, id int) int
+		RevokeAllSessions         func(childComplexity int, employeeID int) int
+		SetWorkflow               func(childComplexity int, projectID int, input model.WorkflowInput) int
+		SignOut                   func(childComplexity int) int
+		TransitionTicket          func(childComplexity int, id int, to model.Status) int
+		UpdateEmployee            func(childComplexity int, id int, input model.EmployeePatchInput) int
+		UpdateProject             func(childComplexity int, id int, input model.ProjectPatchInput) int
 		UpdateProjectEmployeeRole func(childComplexity int, input model.ProjectEmployeeInput) int
-		UpdateTask                func(childComplexity int, id int, input model.TaskInput) int
-		UpdateTeam                func(childComplexity int, id int, input model.TeamInput) int
-		UpdateTicket              func(childComplexity int, id int, input model.TicketInput) int
+		UpdateTask                func(childComplexity int, id int, input model.TaskPatchInput) int
+		UpdateTeam                func(childComplexity int, id int, input model.TeamPatchInput) int
+		UpdateTicket              func(childComplexity int, id int, input model.TicketPatchInput) int
 	}
 
 	Notification struct {
@@ -91,17 +191,51 @@ type ComplexityRoot struct {
 		Type       func(childComplexity int) int
 	}
 
+	NotificationConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	NotificationEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	PageInfo struct {
+		EndCursor       func(childComplexity int) int
+		HasNextPage     func(childComplexity int) int
+		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
+	}
+
 	Project struct {
 		CreatedAt   func(childComplexity int) int
+		DeletedAt   func(childComplexity int) int
 		Description func(childComplexity int) int
+		History     func(childComplexity int) int
 		ID          func(childComplexity int) int
+		Manager     func(childComplexity int) int
 		ManagerID   func(childComplexity int) int
 		Name        func(childComplexity int) int
 		StartDate   func(childComplexity int) int
 		Status      func(childComplexity int) int
+		Teams       func(childComplexity int) int
+		Tickets     func(childComplexity int) int
 		UpdatedAt   func(childComplexity int) int
 	}
 
+	ProjectConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	ProjectEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	ProjectEmployee struct {
 		CreatedAt  func(childComplexity int) int
 		EmployeeID func(childComplexity int) int
@@ -115,33 +249,53 @@ type ComplexityRoot struct {
 		TeamID    func(childComplexity int) int
 	}
 
+	PurgeResult struct {
+		Comments  func(childComplexity int) int
+		Cutoff    func(childComplexity int) int
+		Employees func(childComplexity int) int
+		Projects  func(childComplexity int) int
+		Tasks     func(childComplexity int) int
+		Teams     func(childComplexity int) int
+		Tickets   func(childComplexity int) int
+	}
+
 	Query struct {
+		AuditLog           func(childComplexity int, filter *model.AuditLogFilter, sort []*model.SortInput, first *int, after *string, last *int, before *string) int
 		Employee           func(childComplexity int, id int) int
-		Employees          func(childComplexity int, filter *model.EmployeeFilter) int
+		Employees          func(childComplexity int, filter *model.EmployeeFilter, sort []*model.SortInput, first *int, after *string, last *int, before *string) int
 		EmployeesByProject func(childComplexity int, projectID int) int
 		EngineersByTeam    func(childComplexity int, teamID int) int
-		Notifications      func(childComplexity int, employeeID int, filter *model.NotificationFilter) int
+		Notifications      func(childComplexity int, employeeID int, filter *model.NotificationFilter, sort []*model.SortInput, first *int, after *string, last *int, before *string) int
 		Project            func(childComplexity int, id int) int
 		ProjectEmployees   func(childComplexity int, projectID int) int
 		ProjectTeams       func(childComplexity int, projectID int) int
-		Projects           func(childComplexity int, filter *model.ProjectFilter) int
+		Projects           func(childComplexity int, filter *model.ProjectFilter, sort []*model.SortInput, first *int, after *string, last *int, before *string) int
 		SignIn             func(childComplexity int, email string, password string) int
 		Task               func(childComplexity int, id int) int
-		Tasks              func(childComplexity int, filter *model.TaskFilter) int
+		Tasks              func(childComplexity int, filter *model.TaskFilter, sort []*model.SortInput, first *int, after *string, last *int, before *string) int
 		Team               func(childComplexity int, id int) int
 		TeamEngineers      func(childComplexity int, teamID int) int
-		Teams              func(childComplexity int, filter *model.TeamFilter) int
+		Teams              func(childComplexity int, filter *model.TeamFilter, sort []*model.SortInput, first *int, after *string, last *int, before *string) int
 		TeamsByProject     func(childComplexity int, projectID int) int
 		Ticket             func(childComplexity int, id int) int
-		Tickets            func(childComplexity int, filter *model.TicketFilter) int
+		Tickets            func(childComplexity int, filter *model.TicketFilter, sort []*model.SortInput, first *int, after *string, last *int, before *string) int
+		TrashedEmployees   func(childComplexity int, sort []*model.SortInput, first *int, after *string, last *int, before *string) int
+		TrashedProjects    func(childComplexity int, sort []*model.SortInput, first *int, after *string, last *int, before *string) int
+		TrashedTasks       func(childComplexity int, sort []*model.SortInput, first *int, after *string, last *int, before *string) int
+		TrashedTeams       func(childComplexity int, sort []*model.SortInput, first *int, after *string, last *int, before *string) int
+		TrashedTickets     func(childComplexity int, sort []*model.SortInput, first *int, after *string, last *int, before *string) int
+		Workflow           func(childComplexity int, projectID *int) int
 	}
 
 	Task struct {
 		AssignedToID func(childComplexity int) int
+		Comments     func(childComplexity int, sort []*model.SortInput, first *int, after *string, last *int, before *string) int
 		CompletedAt  func(childComplexity int) int
 		CreatedAt    func(childComplexity int) int
+		DeletedAt    func(childComplexity int) int
 		Description  func(childComplexity int) int
 		DueDate      func(childComplexity int) int
+		History      func(childComplexity int) int
 		ID           func(childComplexity int) int
 		Priority     func(childComplexity int) int
 		ProjectID    func(childComplexity int) int
@@ -149,15 +303,40 @@ type ComplexityRoot struct {
 		Title        func(childComplexity int) int
 	}
 
+	TaskConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	TaskEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	Team struct {
 		CreatedAt    func(childComplexity int) int
+		DeletedAt    func(childComplexity int) int
 		Description  func(childComplexity int) int
+		Engineers    func(childComplexity int) int
 		ID           func(childComplexity int) int
+		Leader       func(childComplexity int) int
 		Name         func(childComplexity int) int
 		TeamLeaderID func(childComplexity int) int
 		UpdatedAt    func(childComplexity int) int
 	}
 
+	TeamConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	TeamEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	TeamEngineer struct {
 		CreatedAt  func(childComplexity int) int
 		EngineerID func(childComplexity int) int
@@ -166,15 +345,43 @@ type ComplexityRoot struct {
 
 	Ticket struct {
 		AssignedToID func(childComplexity int) int
+		Assignee     func(childComplexity int) int
+		Comments     func(childComplexity int, sort []*model.SortInput, first *int, after *string, last *int, before *string) int
 		CompletedAt  func(childComplexity int) int
 		CreatedAt    func(childComplexity int) int
+		DeletedAt    func(childComplexity int) int
 		Description  func(childComplexity int) int
+		History      func(childComplexity int) int
 		ID           func(childComplexity int) int
 		Priority     func(childComplexity int) int
 		ProjectID    func(childComplexity int) int
 		Status       func(childComplexity int) int
 		Title        func(childComplexity int) int
 	}
+
+	TicketConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	TicketEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	Workflow struct {
+		IsDefault   func(childComplexity int) int
+		ProjectID   func(childComplexity int) int
+		States      func(childComplexity int) int
+		Transitions func(childComplexity int) int
+	}
+
+	WorkflowTransition struct {
+		From         func(childComplexity int) int
+		RequiredRole func(childComplexity int) int
+		To           func(childComplexity int) int
+	}
 }
 
 type executableSchema struct {
@@ -196,6 +403,286 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	_ = ec
 	switch typeName + "." + field {
 
+	case "AuditEntry.actor":
+		if e.complexity.AuditEntry.Actor == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.Actor(childComplexity), true
+
+	case "AuditEntry.actorID":
+		if e.complexity.AuditEntry.ActorID == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.ActorID(childComplexity), true
+
+	case "AuditEntry.after":
+		if e.complexity.AuditEntry.After == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.After(childComplexity), true
+
+	case "AuditEntry.before":
+		if e.complexity.AuditEntry.Before == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.Before(childComplexity), true
+
+	case "AuditEntry.createdAt":
+		if e.complexity.AuditEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.CreatedAt(childComplexity), true
+
+	case "AuditEntry.entityID":
+		if e.complexity.AuditEntry.EntityID == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.EntityID(childComplexity), true
+
+	case "AuditEntry.entityType":
+		if e.complexity.AuditEntry.EntityType == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.EntityType(childComplexity), true
+
+	case "AuditEntry.id":
+		if e.complexity.AuditEntry.ID == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.ID(childComplexity), true
+
+	case "AuditEntry.operation":
+		if e.complexity.AuditEntry.Operation == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.Operation(childComplexity), true
+
+	case "AuditEntry.requestID":
+		if e.complexity.AuditEntry.RequestID == nil {
+			break
+		}
+
+		return e.complexity.AuditEntry.RequestID(childComplexity), true
+
+	case "AuditEntryConnection.edges":
+		if e.complexity.AuditEntryConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.AuditEntryConnection.Edges(childComplexity), true
+
+	case "AuditEntryConnection.pageInfo":
+		if e.complexity.AuditEntryConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.AuditEntryConnection.PageInfo(childComplexity), true
+
+	case "AuditEntryConnection.totalCount":
+		if e.complexity.AuditEntryConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.AuditEntryConnection.TotalCount(childComplexity), true
+
+	case "AuditEntryEdge.cursor":
+		if e.complexity.AuditEntryEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.AuditEntryEdge.Cursor(childComplexity), true
+
+	case "AuditEntryEdge.node":
+		if e.complexity.AuditEntryEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.AuditEntryEdge.Node(childComplexity), true
+
+	case "AuthPayload.accessToken":
+		if e.complexity.AuthPayload.AccessToken == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.AccessToken(childComplexity), true
+
+	case "AuthPayload.employee":
+		if e.complexity.AuthPayload.Employee == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.Employee(childComplexity), true
+
+	case "AuthPayload.expiresAt":
+		if e.complexity.AuthPayload.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.ExpiresAt(childComplexity), true
+
+	case "AuthPayload.refreshToken":
+		if e.complexity.AuthPayload.RefreshToken == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.RefreshToken(childComplexity), true
+
+	case "AuthPayload.refreshTokenExpiresAt":
+		if e.complexity.AuthPayload.RefreshTokenExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.RefreshTokenExpiresAt(childComplexity), true
+
+	case "Comment.author":
+		if e.complexity.Comment.Author == nil {
+			break
+		}
+
+		return e.complexity.Comment.Author(childComplexity), true
+
+	case "Comment.authorID":
+		if e.complexity.Comment.AuthorID == nil {
+			break
+		}
+
+		return e.complexity.Comment.AuthorID(childComplexity), true
+
+	case "Comment.body":
+		if e.complexity.Comment.Body == nil {
+			break
+		}
+
+		return e.complexity.Comment.Body(childComplexity), true
+
+	case "Comment.createdAt":
+		if e.complexity.Comment.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Comment.CreatedAt(childComplexity), true
+
+	case "Comment.editedAt":
+		if e.complexity.Comment.EditedAt == nil {
+			break
+		}
+
+		return e.complexity.Comment.EditedAt(childComplexity), true
+
+	case "Comment.id":
+		if e.complexity.Comment.ID == nil {
+			break
+		}
+
+		return e.complexity.Comment.ID(childComplexity), true
+
+	case "Comment.parentID":
+		if e.complexity.Comment.ParentID == nil {
+			break
+		}
+
+		return e.complexity.Comment.ParentID(childComplexity), true
+
+	case "Comment.replies":
+		if e.complexity.Comment.Replies == nil {
+			break
+		}
+
+		return e.complexity.Comment.Replies(childComplexity), true
+
+	case "Comment.revisions":
+		if e.complexity.Comment.Revisions == nil {
+			break
+		}
+
+		return e.complexity.Comment.Revisions(childComplexity), true
+
+	case "Comment.taskID":
+		if e.complexity.Comment.TaskID == nil {
+			break
+		}
+
+		return e.complexity.Comment.TaskID(childComplexity), true
+
+	case "Comment.ticketID":
+		if e.complexity.Comment.TicketID == nil {
+			break
+		}
+
+		return e.complexity.Comment.TicketID(childComplexity), true
+
+	case "Comment.updatedAt":
+		if e.complexity.Comment.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Comment.UpdatedAt(childComplexity), true
+
+	case "CommentConnection.edges":
+		if e.complexity.CommentConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CommentConnection.Edges(childComplexity), true
+
+	case "CommentConnection.pageInfo":
+		if e.complexity.CommentConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CommentConnection.PageInfo(childComplexity), true
+
+	case "CommentConnection.totalCount":
+		if e.complexity.CommentConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CommentConnection.TotalCount(childComplexity), true
+
+	case "CommentEdge.cursor":
+		if e.complexity.CommentEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CommentEdge.Cursor(childComplexity), true
+
+	case "CommentEdge.node":
+		if e.complexity.CommentEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CommentEdge.Node(childComplexity), true
+
+	case "CommentRevision.body":
+		if e.complexity.CommentRevision.Body == nil {
+			break
+		}
+
+		return e.complexity.CommentRevision.Body(childComplexity), true
+
+	case "CommentRevision.createdAt":
+		if e.complexity.CommentRevision.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.CommentRevision.CreatedAt(childComplexity), true
+
+	case "CommentRevision.id":
+		if e.complexity.CommentRevision.ID == nil {
+			break
+		}
+
+		return e.complexity.CommentRevision.ID(childComplexity), true
+
 	case "Employee.active":
 		if e.complexity.Employee.Active == nil {
 			break
@@ -210,6 +697,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Employee.CreatedAt(childComplexity), true
 
+	case "Employee.deletedAt":
+		if e.complexity.Employee.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.Employee.DeletedAt(childComplexity), true
+
 	case "Employee.email":
 		if e.complexity.Employee.Email == nil {
 			break
@@ -238,6 +732,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Employee.ProjectAssignedID(childComplexity), true
 
+	case "Employee.projects":
+		if e.complexity.Employee.Projects == nil {
+			break
+		}
+
+		return e.complexity.Employee.Projects(childComplexity), true
+
 	case "Employee.role":
 		if e.complexity.Employee.Role == nil {
 			break
@@ -252,6 +753,53 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Employee.UpdatedAt(childComplexity), true
 
+	case "EmployeeConnection.edges":
+		if e.complexity.EmployeeConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.EmployeeConnection.Edges(childComplexity), true
+
+	case "EmployeeConnection.pageInfo":
+		if e.complexity.EmployeeConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.EmployeeConnection.PageInfo(childComplexity), true
+
+	case "EmployeeConnection.totalCount":
+		if e.complexity.EmployeeConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.EmployeeConnection.TotalCount(childComplexity), true
+
+	case "EmployeeEdge.cursor":
+		if e.complexity.EmployeeEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.EmployeeEdge.Cursor(childComplexity), true
+
+	case "EmployeeEdge.node":
+		if e.complexity.EmployeeEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.EmployeeEdge.Node(childComplexity), true
+
+	case "Mutation.addComment":
+		if e.complexity.Mutation.AddComment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addComment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddComment(childComplexity, args["input"].(model.CommentInput)), true
+
 	case "Mutation.addEmployee":
 		if e.complexity.Mutation.AddEmployee == nil {
 			break
@@ -276,197 +824,372 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.AddNotification(childComplexity, args["message"].(string), args["employeeID"].(int), args["type"].(*model.NotificationType)), true
 
-	case "Mutation.addProject":
-		if e.complexity.Mutation.AddProject == nil {
+	case "Mutation.addProject":
+		if e.complexity.Mutation.AddProject == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addProject_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddProject(childComplexity, args["input"].(model.ProjectInput)), true
+
+	case "Mutation.addProjectEmployee":
+		if e.complexity.Mutation.AddProjectEmployee == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addProjectEmployee_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddProjectEmployee(childComplexity, args["input"].(model.ProjectEmployeeInput)), true
+
+	case "Mutation.addProjectTeam":
+		if e.complexity.Mutation.AddProjectTeam == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addProjectTeam_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddProjectTeam(childComplexity, args["input"].(model.ProjectTeamInput)), true
+
+	case "Mutation.addTask":
+		if e.complexity.Mutation.AddTask == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addTask_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddTask(childComplexity, args["input"].(model.TaskInput)), true
+
+	case "Mutation.addTeam":
+		if e.complexity.Mutation.AddTeam == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addTeam_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddTeam(childComplexity, args["input"].(model.TeamInput)), true
+
+	case "Mutation.addTeamEngineer":
+		if e.complexity.Mutation.AddTeamEngineer == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addTeamEngineer_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddTeamEngineer(childComplexity, args["input"].(model.TeamEngineerInput)), true
+
+	case "Mutation.addTicket":
+		if e.complexity.Mutation.AddTicket == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addTicket_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddTicket(childComplexity, args["input"].(model.TicketInput)), true
+
+	case "Mutation.changePassword":
+		if e.complexity.Mutation.ChangePassword == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_changePassword_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ChangePassword(childComplexity, args["oldPassword"].(string), args["newPassword"].(string)), true
+
+	case "Mutation.deleteComment":
+		if e.complexity.Mutation.DeleteComment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteComment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteComment(childComplexity, args["id"].(int)), true
+
+	case "Mutation.deleteEmployee":
+		if e.complexity.Mutation.DeleteEmployee == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteEmployee_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteEmployee(childComplexity, args["id"].(int)), true
+
+	case "Mutation.deleteProject":
+		if e.complexity.Mutation.DeleteProject == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteProject_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteProject(childComplexity, args["id"].(int)), true
+
+	case "Mutation.deleteTask":
+		if e.complexity.Mutation.DeleteTask == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteTask_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteTask(childComplexity, args["id"].(int)), true
+
+	case "Mutation.deleteTeam":
+		if e.complexity.Mutation.DeleteTeam == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteTeam_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteTeam(childComplexity, args["id"].(int)), true
+
+	case "Mutation.deleteTicket":
+		if e.complexity.Mutation.DeleteTicket == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteTicket_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteTicket(childComplexity, args["id"].(int)), true
+
+	case "Mutation.editComment":
+		if e.complexity.Mutation.EditComment == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addProject_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_editComment_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.AddProject(childComplexity, args["input"].(model.ProjectInput)), true
+		return e.complexity.Mutation.EditComment(childComplexity, args["id"].(int), args["body"].(string)), true
 
-	case "Mutation.addProjectEmployee":
-		if e.complexity.Mutation.AddProjectEmployee == nil {
+	case "Mutation.markNotificationRead":
+		if e.complexity.Mutation.MarkNotificationRead == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addProjectEmployee_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_markNotificationRead_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.AddProjectEmployee(childComplexity, args["input"].(model.ProjectEmployeeInput)), true
+		return e.complexity.Mutation.MarkNotificationRead(childComplexity, args["id"].(int)), true
 
-	case "Mutation.addProjectTeam":
-		if e.complexity.Mutation.AddProjectTeam == nil {
+	case "Mutation.purgeTrash":
+		if e.complexity.Mutation.PurgeTrash == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addProjectTeam_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_purgeTrash_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.AddProjectTeam(childComplexity, args["input"].(model.ProjectTeamInput)), true
+		return e.complexity.Mutation.PurgeTrash(childComplexity, args["olderThanDays"].(*int)), true
 
-	case "Mutation.addTask":
-		if e.complexity.Mutation.AddTask == nil {
+	case "Mutation.refreshSession":
+		if e.complexity.Mutation.RefreshSession == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addTask_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_refreshSession_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.AddTask(childComplexity, args["input"].(model.TaskInput)), true
+		return e.complexity.Mutation.RefreshSession(childComplexity, args["refreshToken"].(string)), true
 
-	case "Mutation.addTeam":
-		if e.complexity.Mutation.AddTeam == nil {
+	case "Mutation.removeProjectEmployee":
+		if e.complexity.Mutation.RemoveProjectEmployee == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addTeam_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_removeProjectEmployee_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.AddTeam(childComplexity, args["input"].(model.TeamInput)), true
+		return e.complexity.Mutation.RemoveProjectEmployee(childComplexity, args["input"].(model.ProjectEmployeeInput)), true
 
-	case "Mutation.addTeamEngineer":
-		if e.complexity.Mutation.AddTeamEngineer == nil {
+	case "Mutation.removeProjectTeam":
+		if e.complexity.Mutation.RemoveProjectTeam == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addTeamEngineer_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_removeProjectTeam_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.AddTeamEngineer(childComplexity, args["input"].(model.TeamEngineerInput)), true
+		return e.complexity.Mutation.RemoveProjectTeam(childComplexity, args["input"].(model.ProjectTeamInput)), true
 
-	case "Mutation.addTicket":
-		if e.complexity.Mutation.AddTicket == nil {
+	case "Mutation.removeTeamEngineer":
+		if e.complexity.Mutation.RemoveTeamEngineer == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addTicket_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_removeTeamEngineer_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.AddTicket(childComplexity, args["input"].(model.TicketInput)), true
+		return e.complexity.Mutation.RemoveTeamEngineer(childComplexity, args["input"].(model.TeamEngineerInput)), true
 
-	case "Mutation.deleteEmployee":
-		if e.complexity.Mutation.DeleteEmployee == nil {
+	case "Mutation.resetWorkflow":
+		if e.complexity.Mutation.ResetWorkflow == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteEmployee_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_resetWorkflow_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DeleteEmployee(childComplexity, args["id"].(int)), true
+		return e.complexity.Mutation.ResetWorkflow(childComplexity, args["projectID"].(int)), true
 
-	case "Mutation.deleteProject":
-		if e.complexity.Mutation.DeleteProject == nil {
+	case "Mutation.restoreEmployee":
+		if e.complexity.Mutation.RestoreEmployee == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteProject_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreEmployee_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DeleteProject(childComplexity, args["id"].(int)), true
+		return e.complexity.Mutation.RestoreEmployee(childComplexity, args["id"].(int)), true
 
-	case "Mutation.deleteTask":
-		if e.complexity.Mutation.DeleteTask == nil {
+	case "Mutation.restoreProject":
+		if e.complexity.Mutation.RestoreProject == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteTask_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreProject_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DeleteTask(childComplexity, args["id"].(int)), true
+		return e.complexity.Mutation.RestoreProject(childComplexity, args["id"].(int)), true
 
-	case "Mutation.deleteTeam":
-		if e.complexity.Mutation.DeleteTeam == nil {
+	case "Mutation.restoreTask":
+		if e.complexity.Mutation.RestoreTask == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteTeam_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreTask_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DeleteTeam(childComplexity, args["id"].(int)), true
+		return e.complexity.Mutation.RestoreTask(childComplexity, args["id"].(int)), true
 
-	case "Mutation.deleteTicket":
-		if e.complexity.Mutation.DeleteTicket == nil {
+	case "Mutation.restoreTeam":
+		if e.complexity.Mutation.RestoreTeam == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteTicket_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreTeam_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DeleteTicket(childComplexity, args["id"].(int)), true
+		return e.complexity.Mutation.RestoreTeam(childComplexity, args["id"].(int)), true
 
-	case "Mutation.markNotificationRead":
-		if e.complexity.Mutation.MarkNotificationRead == nil {
+	case "Mutation.restoreTicket":
+		if e.complexity.Mutation.RestoreTicket == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_markNotificationRead_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_restoreTicket_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.MarkNotificationRead(childComplexity, args["id"].(int)), true
+		return e.complexity.Mutation.RestoreTicket(childComplexity, args["id"].(int)), true
 
-	case "Mutation.removeProjectEmployee":
-		if e.complexity.Mutation.RemoveProjectEmployee == nil {
+	case "Mutation.revokeAllSessions":
+		if e.complexity.Mutation.RevokeAllSessions == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_removeProjectEmployee_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_revokeAllSessions_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.RemoveProjectEmployee(childComplexity, args["input"].(model.ProjectEmployeeInput)), true
+		return e.complexity.Mutation.RevokeAllSessions(childComplexity, args["employeeID"].(int)), true
 
-	case "Mutation.removeProjectTeam":
-		if e.complexity.Mutation.RemoveProjectTeam == nil {
+	case "Mutation.setWorkflow":
+		if e.complexity.Mutation.SetWorkflow == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_removeProjectTeam_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_setWorkflow_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.RemoveProjectTeam(childComplexity, args["input"].(model.ProjectTeamInput)), true
+		return e.complexity.Mutation.SetWorkflow(childComplexity, args["projectID"].(int), args["input"].(model.WorkflowInput)), true
 
-	case "Mutation.removeTeamEngineer":
-		if e.complexity.Mutation.RemoveTeamEngineer == nil {
+	case "Mutation.signOut":
+		if e.complexity.Mutation.SignOut == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_removeTeamEngineer_args(ctx, rawArgs)
+		return e.complexity.Mutation.SignOut(childComplexity), true
+
+	case "Mutation.transitionTicket":
+		if e.complexity.Mutation.TransitionTicket == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_transitionTicket_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.RemoveTeamEngineer(childComplexity, args["input"].(model.TeamEngineerInput)), true
+		return e.complexity.Mutation.TransitionTicket(childComplexity, args["id"].(int), args["to"].(model.Status)), true
 
 	case "Mutation.updateEmployee":
 		if e.complexity.Mutation.UpdateEmployee == nil {
@@ -478,7 +1201,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.Mutation.UpdateEmployee(childComplexity, args["id"].(int), args["input"].(model.EmployeeInput)), true
+		return e.complexity.Mutation.UpdateEmployee(childComplexity, args["id"].(int), args["input"].(model.EmployeePatchInput)), true
 
 	case "Mutation.updateProject":
 		if e.complexity.Mutation.UpdateProject == nil {
@@ -490,7 +1213,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.Mutation.UpdateProject(childComplexity, args["id"].(int), args["input"].(model.ProjectInput)), true
+		return e.complexity.Mutation.UpdateProject(childComplexity, args["id"].(int), args["input"].(model.ProjectPatchInput)), true
 
 	case "Mutation.updateProjectEmployeeRole":
 		if e.complexity.Mutation.UpdateProjectEmployeeRole == nil {
@@ -514,7 +1237,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.Mutation.UpdateTask(childComplexity, args["id"].(int), args["input"].(model.TaskInput)), true
+		return e.complexity.Mutation.UpdateTask(childComplexity, args["id"].(int), args["input"].(model.TaskPatchInput)), true
 
 	case "Mutation.updateTeam":
 		if e.complexity.Mutation.UpdateTeam == nil {
@@ -526,7 +1249,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.Mutation.UpdateTeam(childComplexity, args["id"].(int), args["input"].(model.TeamInput)), true
+		return e.complexity.Mutation.UpdateTeam(childComplexity, args["id"].(int), args["input"].(model.TeamPatchInput)), true
 
 	case "Mutation.updateTicket":
 		if e.complexity.Mutation.UpdateTicket == nil {
@@ -538,7 +1261,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.Mutation.UpdateTicket(childComplexity, args["id"].(int), args["input"].(model.TicketInput)), true
+		return e.complexity.Mutation.UpdateTicket(childComplexity, args["id"].(int), args["input"].(model.TicketPatchInput)), true
 
 	case "Notification.createdAt":
 		if e.complexity.Notification.CreatedAt == nil {
@@ -582,6 +1305,69 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Notification.Type(childComplexity), true
 
+	case "NotificationConnection.edges":
+		if e.complexity.NotificationConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.NotificationConnection.Edges(childComplexity), true
+
+	case "NotificationConnection.pageInfo":
+		if e.complexity.NotificationConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.NotificationConnection.PageInfo(childComplexity), true
+
+	case "NotificationConnection.totalCount":
+		if e.complexity.NotificationConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.NotificationConnection.TotalCount(childComplexity), true
+
+	case "NotificationEdge.cursor":
+		if e.complexity.NotificationEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.NotificationEdge.Cursor(childComplexity), true
+
+	case "NotificationEdge.node":
+		if e.complexity.NotificationEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.NotificationEdge.Node(childComplexity), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
 	case "Project.createdAt":
 		if e.complexity.Project.CreatedAt == nil {
 			break
@@ -589,6 +1375,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Project.CreatedAt(childComplexity), true
 
+	case "Project.deletedAt":
+		if e.complexity.Project.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.Project.DeletedAt(childComplexity), true
+
 	case "Project.description":
 		if e.complexity.Project.Description == nil {
 			break
@@ -596,6 +1389,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Project.Description(childComplexity), true
 
+	case "Project.history":
+		if e.complexity.Project.History == nil {
+			break
+		}
+
+		return e.complexity.Project.History(childComplexity), true
+
 	case "Project.id":
 		if e.complexity.Project.ID == nil {
 			break
@@ -603,6 +1403,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Project.ID(childComplexity), true
 
+	case "Project.manager":
+		if e.complexity.Project.Manager == nil {
+			break
+		}
+
+		return e.complexity.Project.Manager(childComplexity), true
+
 	case "Project.managerID":
 		if e.complexity.Project.ManagerID == nil {
 			break
@@ -631,6 +1438,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Project.Status(childComplexity), true
 
+	case "Project.teams":
+		if e.complexity.Project.Teams == nil {
+			break
+		}
+
+		return e.complexity.Project.Teams(childComplexity), true
+
+	case "Project.tickets":
+		if e.complexity.Project.Tickets == nil {
+			break
+		}
+
+		return e.complexity.Project.Tickets(childComplexity), true
+
 	case "Project.updatedAt":
 		if e.complexity.Project.UpdatedAt == nil {
 			break
@@ -638,6 +1459,41 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Project.UpdatedAt(childComplexity), true
 
+	case "ProjectConnection.edges":
+		if e.complexity.ProjectConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.ProjectConnection.Edges(childComplexity), true
+
+	case "ProjectConnection.pageInfo":
+		if e.complexity.ProjectConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.ProjectConnection.PageInfo(childComplexity), true
+
+	case "ProjectConnection.totalCount":
+		if e.complexity.ProjectConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.ProjectConnection.TotalCount(childComplexity), true
+
+	case "ProjectEdge.cursor":
+		if e.complexity.ProjectEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.ProjectEdge.Cursor(childComplexity), true
+
+	case "ProjectEdge.node":
+		if e.complexity.ProjectEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.ProjectEdge.Node(childComplexity), true
+
 	case "ProjectEmployee.createdAt":
 		if e.complexity.ProjectEmployee.CreatedAt == nil {
 			break
@@ -687,6 +1543,67 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.ProjectTeam.TeamID(childComplexity), true
 
+	case "PurgeResult.comments":
+		if e.complexity.PurgeResult.Comments == nil {
+			break
+		}
+
+		return e.complexity.PurgeResult.Comments(childComplexity), true
+
+	case "PurgeResult.cutoff":
+		if e.complexity.PurgeResult.Cutoff == nil {
+			break
+		}
+
+		return e.complexity.PurgeResult.Cutoff(childComplexity), true
+
+	case "PurgeResult.employees":
+		if e.complexity.PurgeResult.Employees == nil {
+			break
+		}
+
+		return e.complexity.PurgeResult.Employees(childComplexity), true
+
+	case "PurgeResult.projects":
+		if e.complexity.PurgeResult.Projects == nil {
+			break
+		}
+
+		return e.complexity.PurgeResult.Projects(childComplexity), true
+
+	case "PurgeResult.tasks":
+		if e.complexity.PurgeResult.Tasks == nil {
+			break
+		}
+
+		return e.complexity.PurgeResult.Tasks(childComplexity), true
+
+	case "PurgeResult.teams":
+		if e.complexity.PurgeResult.Teams == nil {
+			break
+		}
+
+		return e.complexity.PurgeResult.Teams(childComplexity), true
+
+	case "PurgeResult.tickets":
+		if e.complexity.PurgeResult.Tickets == nil {
+			break
+		}
+
+		return e.complexity.PurgeResult.Tickets(childComplexity), true
+
+	case "Query.auditLog":
+		if e.complexity.Query.AuditLog == nil {
+			break
+		}
+
+		args, err := ec.field_Query_auditLog_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AuditLog(childComplexity, args["filter"].(*model.AuditLogFilter), args["sort"].([]*model.SortInput), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
+
 	case "Query.employee":
 		if e.complexity.Query.Employee == nil {
 			break
@@ -709,7 +1626,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.Query.Employees(childComplexity, args["filter"].(*model.EmployeeFilter)), true
+		return e.complexity.Query.Employees(childComplexity, args["filter"].(*model.EmployeeFilter), args["sort"].([]*model.SortInput), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
 
 	case "Query.employeesByProject":
 		if e.complexity.Query.EmployeesByProject == nil {
@@ -745,7 +1662,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.Query.Notifications(childComplexity, args["employeeID"].(int), args["filter"].(*model.NotificationFilter)), true
+		return e.complexity.Query.Notifications(childComplexity, args["employeeID"].(int), args["filter"].(*model.NotificationFilter), args["sort"].([]*model.SortInput), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
 
 	case "Query.project":
 		if e.complexity.Query.Project == nil {
@@ -793,7 +1710,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.Query.Projects(childComplexity, args["filter"].(*model.ProjectFilter)), true
+		return e.complexity.Query.Projects(childComplexity, args["filter"].(*model.ProjectFilter), args["sort"].([]*model.SortInput), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
 
 	case "Query.signIn":
 		if e.complexity.Query.SignIn == nil {
@@ -829,7 +1746,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.Query.Tasks(childComplexity, args["filter"].(*model.TaskFilter)), true
+		return e.complexity.Query.Tasks(childComplexity, args["filter"].(*model.TaskFilter), args["sort"].([]*model.SortInput), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
 
 	case "Query.team":
 		if e.complexity.Query.Team == nil {
@@ -865,43 +1782,115 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.Query.Teams(childComplexity, args["filter"].(*model.TeamFilter)), true
+		return e.complexity.Query.Teams(childComplexity, args["filter"].(*model.TeamFilter), args["sort"].([]*model.SortInput), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
+
+	case "Query.teamsByProject":
+		if e.complexity.Query.TeamsByProject == nil {
+			break
+		}
+
+		args, err := ec.field_Query_teamsByProject_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TeamsByProject(childComplexity, args["projectID"].(int)), true
+
+	case "Query.ticket":
+		if e.complexity.Query.Ticket == nil {
+			break
+		}
+
+		args, err := ec.field_Query_ticket_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Ticket(childComplexity, args["id"].(int)), true
+
+	case "Query.tickets":
+		if e.complexity.Query.Tickets == nil {
+			break
+		}
+
+		args, err := ec.field_Query_tickets_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Tickets(childComplexity, args["filter"].(*model.TicketFilter), args["sort"].([]*model.SortInput), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
+
+	case "Query.trashedEmployees":
+		if e.complexity.Query.TrashedEmployees == nil {
+			break
+		}
+
+		args, err := ec.field_Query_trashedEmployees_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TrashedEmployees(childComplexity, args["sort"].([]*model.SortInput), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
+
+	case "Query.trashedProjects":
+		if e.complexity.Query.TrashedProjects == nil {
+			break
+		}
+
+		args, err := ec.field_Query_trashedProjects_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TrashedProjects(childComplexity, args["sort"].([]*model.SortInput), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
+
+	case "Query.trashedTasks":
+		if e.complexity.Query.TrashedTasks == nil {
+			break
+		}
+
+		args, err := ec.field_Query_trashedTasks_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TrashedTasks(childComplexity, args["sort"].([]*model.SortInput), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
 
-	case "Query.teamsByProject":
-		if e.complexity.Query.TeamsByProject == nil {
+	case "Query.trashedTeams":
+		if e.complexity.Query.TrashedTeams == nil {
 			break
 		}
 
-		args, err := ec.field_Query_teamsByProject_args(ctx, rawArgs)
+		args, err := ec.field_Query_trashedTeams_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.TeamsByProject(childComplexity, args["projectID"].(int)), true
+		return e.complexity.Query.TrashedTeams(childComplexity, args["sort"].([]*model.SortInput), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
 
-	case "Query.ticket":
-		if e.complexity.Query.Ticket == nil {
+	case "Query.trashedTickets":
+		if e.complexity.Query.TrashedTickets == nil {
 			break
 		}
 
-		args, err := ec.field_Query_ticket_args(ctx, rawArgs)
+		args, err := ec.field_Query_trashedTickets_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Ticket(childComplexity, args["id"].(int)), true
+		return e.complexity.Query.TrashedTickets(childComplexity, args["sort"].([]*model.SortInput), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
 
-	case "Query.tickets":
-		if e.complexity.Query.Tickets == nil {
+	case "Query.workflow":
+		if e.complexity.Query.Workflow == nil {
 			break
 		}
 
-		args, err := ec.field_Query_tickets_args(ctx, rawArgs)
+		args, err := ec.field_Query_workflow_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Tickets(childComplexity, args["filter"].(*model.TicketFilter)), true
+		return e.complexity.Query.Workflow(childComplexity, args["projectID"].(*int)), true
 
 	case "Task.assignedToID":
 		if e.complexity.Task.AssignedToID == nil {
@@ -910,6 +1899,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Task.AssignedToID(childComplexity), true
 
+	case "Task.comments":
+		if e.complexity.Task.Comments == nil {
+			break
+		}
+
+		args, err := ec.field_Task_comments_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Task.Comments(childComplexity, args["sort"].([]*model.SortInput), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
+
 	case "Task.completedAt":
 		if e.complexity.Task.CompletedAt == nil {
 			break
@@ -924,6 +1925,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Task.CreatedAt(childComplexity), true
 
+	case "Task.deletedAt":
+		if e.complexity.Task.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.Task.DeletedAt(childComplexity), true
+
 	case "Task.description":
 		if e.complexity.Task.Description == nil {
 			break
@@ -938,6 +1946,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Task.DueDate(childComplexity), true
 
+	case "Task.history":
+		if e.complexity.Task.History == nil {
+			break
+		}
+
+		return e.complexity.Task.History(childComplexity), true
+
 	case "Task.id":
 		if e.complexity.Task.ID == nil {
 			break
@@ -973,6 +1988,41 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Task.Title(childComplexity), true
 
+	case "TaskConnection.edges":
+		if e.complexity.TaskConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.TaskConnection.Edges(childComplexity), true
+
+	case "TaskConnection.pageInfo":
+		if e.complexity.TaskConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.TaskConnection.PageInfo(childComplexity), true
+
+	case "TaskConnection.totalCount":
+		if e.complexity.TaskConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.TaskConnection.TotalCount(childComplexity), true
+
+	case "TaskEdge.cursor":
+		if e.complexity.TaskEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.TaskEdge.Cursor(childComplexity), true
+
+	case "TaskEdge.node":
+		if e.complexity.TaskEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.TaskEdge.Node(childComplexity), true
+
 	case "Team.createdAt":
 		if e.complexity.Team.CreatedAt == nil {
 			break
@@ -980,6 +2030,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Team.CreatedAt(childComplexity), true
 
+	case "Team.deletedAt":
+		if e.complexity.Team.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.Team.DeletedAt(childComplexity), true
+
 	case "Team.description":
 		if e.complexity.Team.Description == nil {
 			break
@@ -987,6 +2044,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Team.Description(childComplexity), true
 
+	case "Team.engineers":
+		if e.complexity.Team.Engineers == nil {
+			break
+		}
+
+		return e.complexity.Team.Engineers(childComplexity), true
+
 	case "Team.id":
 		if e.complexity.Team.ID == nil {
 			break
@@ -994,6 +2058,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Team.ID(childComplexity), true
 
+	case "Team.leader":
+		if e.complexity.Team.Leader == nil {
+			break
+		}
+
+		return e.complexity.Team.Leader(childComplexity), true
+
 	case "Team.name":
 		if e.complexity.Team.Name == nil {
 			break
@@ -1015,6 +2086,41 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Team.UpdatedAt(childComplexity), true
 
+	case "TeamConnection.edges":
+		if e.complexity.TeamConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.TeamConnection.Edges(childComplexity), true
+
+	case "TeamConnection.pageInfo":
+		if e.complexity.TeamConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.TeamConnection.PageInfo(childComplexity), true
+
+	case "TeamConnection.totalCount":
+		if e.complexity.TeamConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.TeamConnection.TotalCount(childComplexity), true
+
+	case "TeamEdge.cursor":
+		if e.complexity.TeamEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.TeamEdge.Cursor(childComplexity), true
+
+	case "TeamEdge.node":
+		if e.complexity.TeamEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.TeamEdge.Node(childComplexity), true
+
 	case "TeamEngineer.createdAt":
 		if e.complexity.TeamEngineer.CreatedAt == nil {
 			break
@@ -1043,6 +2149,25 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Ticket.AssignedToID(childComplexity), true
 
+	case "Ticket.assignee":
+		if e.complexity.Ticket.Assignee == nil {
+			break
+		}
+
+		return e.complexity.Ticket.Assignee(childComplexity), true
+
+	case "Ticket.comments":
+		if e.complexity.Ticket.Comments == nil {
+			break
+		}
+
+		args, err := ec.field_Ticket_comments_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Ticket.Comments(childComplexity, args["sort"].([]*model.SortInput), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
+
 	case "Ticket.completedAt":
 		if e.complexity.Ticket.CompletedAt == nil {
 			break
@@ -1057,6 +2182,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Ticket.CreatedAt(childComplexity), true
 
+	case "Ticket.deletedAt":
+		if e.complexity.Ticket.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.Ticket.DeletedAt(childComplexity), true
+
 	case "Ticket.description":
 		if e.complexity.Ticket.Description == nil {
 			break
@@ -1064,6 +2196,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Ticket.Description(childComplexity), true
 
+	case "Ticket.history":
+		if e.complexity.Ticket.History == nil {
+			break
+		}
+
+		return e.complexity.Ticket.History(childComplexity), true
+
 	case "Ticket.id":
 		if e.complexity.Ticket.ID == nil {
 			break
@@ -1099,6 +2238,90 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Ticket.Title(childComplexity), true
 
+	case "TicketConnection.edges":
+		if e.complexity.TicketConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.TicketConnection.Edges(childComplexity), true
+
+	case "TicketConnection.pageInfo":
+		if e.complexity.TicketConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.TicketConnection.PageInfo(childComplexity), true
+
+	case "TicketConnection.totalCount":
+		if e.complexity.TicketConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.TicketConnection.TotalCount(childComplexity), true
+
+	case "TicketEdge.cursor":
+		if e.complexity.TicketEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.TicketEdge.Cursor(childComplexity), true
+
+	case "TicketEdge.node":
+		if e.complexity.TicketEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.TicketEdge.Node(childComplexity), true
+
+	case "Workflow.isDefault":
+		if e.complexity.Workflow.IsDefault == nil {
+			break
+		}
+
+		return e.complexity.Workflow.IsDefault(childComplexity), true
+
+	case "Workflow.projectID":
+		if e.complexity.Workflow.ProjectID == nil {
+			break
+		}
+
+		return e.complexity.Workflow.ProjectID(childComplexity), true
+
+	case "Workflow.states":
+		if e.complexity.Workflow.States == nil {
+			break
+		}
+
+		return e.complexity.Workflow.States(childComplexity), true
+
+	case "Workflow.transitions":
+		if e.complexity.Workflow.Transitions == nil {
+			break
+		}
+
+		return e.complexity.Workflow.Transitions(childComplexity), true
+
+	case "WorkflowTransition.from":
+		if e.complexity.WorkflowTransition.From == nil {
+			break
+		}
+
+		return e.complexity.WorkflowTransition.From(childComplexity), true
+
+	case "WorkflowTransition.requiredRole":
+		if e.complexity.WorkflowTransition.RequiredRole == nil {
+			break
+		}
+
+		return e.complexity.WorkflowTransition.RequiredRole(childComplexity), true
+
+	case "WorkflowTransition.to":
+		if e.complexity.WorkflowTransition.To == nil {
+			break
+		}
+
+		return e.complexity.WorkflowTransition.To(childComplexity), true
+
 	}
 	return 0, false
 }
@@ -1107,22 +2330,31 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	opCtx := graphql.GetOperationContext(ctx)
 	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAuditLogFilter,
+		ec.unmarshalInputCommentInput,
 		ec.unmarshalInputEmployeeFilter,
 		ec.unmarshalInputEmployeeInput,
+		ec.unmarshalInputEmployeePatchInput,
 		ec.unmarshalInputLoginDetailsInput,
 		ec.unmarshalInputNotificationFilter,
 		ec.unmarshalInputProjectEmployeeInput,
 		ec.unmarshalInputProjectFilter,
 		ec.unmarshalInputProjectInput,
+		ec.unmarshalInputProjectPatchInput,
 		ec.unmarshalInputProjectTeamInput,
 		ec.unmarshalInputSortInput,
 		ec.unmarshalInputTaskFilter,
 		ec.unmarshalInputTaskInput,
+		ec.unmarshalInputTaskPatchInput,
 		ec.unmarshalInputTeamEngineerInput,
 		ec.unmarshalInputTeamFilter,
 		ec.unmarshalInputTeamInput,
+		ec.unmarshalInputTeamPatchInput,
 		ec.unmarshalInputTicketFilter,
 		ec.unmarshalInputTicketInput,
+		ec.unmarshalInputTicketPatchInput,
+		ec.unmarshalInputWorkflowInput,
+		ec.unmarshalInputWorkflowTransitionInput,
 	)
 	first := true
 
@@ -1222,13 +2454,29 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 var sources = []*ast.Source{
 	{Name: "../graphqls/directives.graphqls", Input: `# ----------- Directives -----------
 
-directive @auth(role: Role!) on FIELD_DEFINITION
+# Authorization directive. ` + "`" + `role` + "`" + ` admits that role and every role above it
+# (ADMIN > MANAGER > TL > EMPLOYEE); ` + "`" + `roles` + "`" + ` admits only the listed roles and ADMIN.
+directive @auth(role: Role, roles: [Role!]) on FIELD_DEFINITION
 
 # Cache directive for caching responses
 directive @cache(ttl: Int!) on FIELD_DEFINITION
 
 # Rate limiting directive
 directive @rateLimit(limit: Int!, window: String!) on FIELD_DEFINITION
+
+# Input constraint, checked before the resolver runs. Every violation in a request is
+# reported at once, one VALIDATION_FAILED error per offending field. Lengths count
+# characters and ignore surrounding whitespace for minLength; format is email or date
+# (YYYY-MM-DD). On a list the constraint applies to each element. notNull rejects an
+# explicit null on an optional field, for patch fields that may be omitted but not cleared.
+directive @constraint(
+  notNull: Boolean
+  minLength: Int
+  maxLength: Int
+  min: Int
+  format: String
+  oneOf: [String!]
+) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
 `, BuiltIn: false},
 	{Name: "../graphqls/filters.graphqls", Input: `# ----------- Filter Types -----------
 
@@ -1289,7 +2537,18 @@ input NotificationFilter {
   createdAtBefore: DateTime
 }
 
-# Sorting inputs
+input AuditLogFilter {
+  actorID: Int
+  entityType: String
+  entityID: String
+  operation: AuditOperation
+  requestID: String
+  createdAtAfter: DateTime
+  createdAtBefore: DateTime
+}
+
+# Sorting inputs. field is a GraphQL field name checked against a per-list whitelist
+# (e.g. tickets: title, status, priority, createdAt, id); priority sorts by urgency.
 input SortInput {
   field: String!
   direction: SortDirection!
@@ -1304,75 +2563,207 @@ enum SortDirection {
 	{Name: "../graphqls/mutations.graphqls", Input: `# ----------- Mutations -----------
 
 type Mutation {
+  refreshSession(refreshToken: String!): AuthPayload! @rateLimit(limit: 10, window: "1m")
+  signOut: Boolean! @auth(role: EMPLOYEE)
+  revokeAllSessions(employeeID: Int!): Boolean! @auth(role: EMPLOYEE)
+  changePassword(oldPassword: String!, newPassword: String!): Boolean! @auth(role: EMPLOYEE) @rateLimit(limit: 5, window: "15m")
+
   addEmployee(input: EmployeeInput!): Employee! @auth(role: ADMIN)
-  updateEmployee(id: Int!, input: EmployeeInput!): Employee!
+  updateEmployee(id: Int!, input: EmployeePatchInput!): Employee! @auth(role: ADMIN)
   deleteEmployee(id: Int!): Boolean!  @auth(role: ADMIN)
+  restoreEmployee(id: Int!): Employee! @auth(role: ADMIN)
+
+  addProject(input: ProjectInput!): Project! @auth(role: MANAGER)
+  updateProject(id: Int!, input: ProjectPatchInput!): Project! @auth(role: MANAGER)
+  deleteProject(id: Int!): Boolean! @auth(role: MANAGER)
+  restoreProject(id: Int!): Project! @auth(role: MANAGER)
+  setWorkflow(projectID: Int! @constraint(min: 1), input: WorkflowInput!): Workflow! @auth(role: MANAGER)
+  resetWorkflow(projectID: Int! @constraint(min: 1)): Workflow! @auth(role: MANAGER)
+
+  addTeam(input: TeamInput!): Team! @auth(role: MANAGER)
+  updateTeam(id: Int!, input: TeamPatchInput!): Team! @auth(role: MANAGER)
+  deleteTeam(id: Int!): Boolean! @auth(role: MANAGER)
+  restoreTeam(id: Int!): Team! @auth(role: MANAGER)
+
+  addTicket(input: TicketInput!): Ticket! @auth(role: EMPLOYEE)
+  updateTicket(id: Int!, input: TicketPatchInput!): Ticket! @auth(role: EMPLOYEE)
+  deleteTicket(id: Int!): Boolean! @auth(role: TL)
+  restoreTicket(id: Int!): Ticket! @auth(role: TL)
+  transitionTicket(id: Int!, to: Status!): Ticket! @auth(role: EMPLOYEE)
+
+  addTask(input: TaskInput!): Task! @auth(role: EMPLOYEE)
+  updateTask(id: Int!, input: TaskPatchInput!): Task! @auth(role: EMPLOYEE)
+  deleteTask(id: Int!): Boolean! @auth(role: TL)
+  restoreTask(id: Int!): Task! @auth(role: TL)
+
+  # Removes rows deleted more than olderThanDays ago for good; never fewer days than the
+  # configured retention, which is the default
+  purgeTrash(olderThanDays: Int @constraint(min: 0)): PurgeResult! @auth(role: ADMIN)
+
+  # Comments are written as the caller, on tickets and tasks they can see
+  addComment(input: CommentInput!): Comment! @auth(role: EMPLOYEE)
+  editComment(id: Int!, body: Markdown! @constraint(minLength: 1, maxLength: 10000)): Comment! @auth(role: EMPLOYEE)
+  # Deletes the comment with its replies
+  deleteComment(id: Int!): Boolean! @auth(role: EMPLOYEE)
+
+  addNotification(message: String! @constraint(minLength: 1, maxLength: 1000), employeeID: Int! @constraint(min: 1), type: NotificationType): Notification! @auth(role: TL)
+  markNotificationRead(id: Int!): Boolean! @auth(role: EMPLOYEE)
+
+
+  addTeamEngineer(input: TeamEngineerInput!): TeamEngineer! @auth(role: TL)
+  removeTeamEngineer(input: TeamEngineerInput!): Boolean! @auth(role: TL)
+  
+  addProjectTeam(input: ProjectTeamInput!): ProjectTeam! @auth(role: MANAGER)
+  removeProjectTeam(input: ProjectTeamInput!): Boolean! @auth(role: MANAGER)
+  
+  addProjectEmployee(input: ProjectEmployeeInput!): ProjectEmployee! @auth(role: MANAGER)
+  removeProjectEmployee(input: ProjectEmployeeInput!): Boolean! @auth(role: MANAGER)
+  updateProjectEmployeeRole(input: ProjectEmployeeInput!): ProjectEmployee! @auth(role: MANAGER)
+}
+`, BuiltIn: false},
+	{Name: "../graphqls/pagination.graphqls", Input: `# ----------- Pagination Types -----------
+
+# Relay connection types. Cursors are opaque and keyed on (createdAt, id), so pages stay
+# stable when rows are inserted while a client is paging.
+type PageInfo {
+  hasNextPage: Boolean!
+  hasPreviousPage: Boolean!
+  startCursor: String
+  endCursor: String
+}
+
+type EmployeeEdge {
+  cursor: String!
+  node: Employee!
+}
 
-  addProject(input: ProjectInput!): Project!
-  updateProject(id: Int!, input: ProjectInput!): Project!
-  deleteProject(id: Int!): Boolean!
+type EmployeeConnection {
+  edges: [EmployeeEdge!]!
+  pageInfo: PageInfo!
+  totalCount: Int!
+}
 
-  addTeam(input: TeamInput!): Team!
-  updateTeam(id: Int!, input: TeamInput!): Team!
-  deleteTeam(id: Int!): Boolean!
+type ProjectEdge {
+  cursor: String!
+  node: Project!
+}
 
-  addTicket(input: TicketInput!): Ticket!
-  updateTicket(id: Int!, input: TicketInput!): Ticket!
-  deleteTicket(id: Int!): Boolean!
+type ProjectConnection {
+  edges: [ProjectEdge!]!
+  pageInfo: PageInfo!
+  totalCount: Int!
+}
 
-  addTask(input: TaskInput!): Task!
-  updateTask(id: Int!, input: TaskInput!): Task!
-  deleteTask(id: Int!): Boolean!
+type TaskEdge {
+  cursor: String!
+  node: Task!
+}
 
-  addNotification(message: String!, employeeID: Int!, type: NotificationType): Notification!
-  markNotificationRead(id: Int!): Boolean!
+type TaskConnection {
+  edges: [TaskEdge!]!
+  pageInfo: PageInfo!
+  totalCount: Int!
+}
 
+type TicketEdge {
+  cursor: String!
+  node: Ticket!
+}
 
-  addTeamEngineer(input: TeamEngineerInput!): TeamEngineer!
-  removeTeamEngineer(input: TeamEngineerInput!): Boolean!
-  
-  addProjectTeam(input: ProjectTeamInput!): ProjectTeam!
-  removeProjectTeam(input: ProjectTeamInput!): Boolean!
-  
-  addProjectEmployee(input: ProjectEmployeeInput!): ProjectEmployee!
-  removeProjectEmployee(input: ProjectEmployeeInput!): Boolean!
-  updateProjectEmployeeRole(input: ProjectEmployeeInput!): ProjectEmployee!
+type TicketConnection {
+  edges: [TicketEdge!]!
+  pageInfo: PageInfo!
+  totalCount: Int!
+}
+
+type TeamEdge {
+  cursor: String!
+  node: Team!
+}
+
+type TeamConnection {
+  edges: [TeamEdge!]!
+  pageInfo: PageInfo!
+  totalCount: Int!
+}
+
+type NotificationEdge {
+  cursor: String!
+  node: Notification!
+}
+
+type NotificationConnection {
+  edges: [NotificationEdge!]!
+  pageInfo: PageInfo!
+  totalCount: Int!
+}
+
+type AuditEntryEdge {
+  cursor: String!
+  node: AuditEntry!
+}
+
+type AuditEntryConnection {
+  edges: [AuditEntryEdge!]!
+  pageInfo: PageInfo!
+  totalCount: Int!
+}
+
+type CommentEdge {
+  cursor: String!
+  node: Comment!
+}
+
+type CommentConnection {
+  edges: [CommentEdge!]!
+  pageInfo: PageInfo!
+  totalCount: Int!
 }
 `, BuiltIn: false},
 	{Name: "../graphqls/query.graphqls", Input: `# ----------- Queries -----------
 
 type Query {
 
-  employees(filter: EmployeeFilter): [Employee!]!
-  employee(id: Int!): Employee
-  signIn(email: String!, password: String!): Boolean!
+  employees(filter: EmployeeFilter, sort: [SortInput!], first: Int, after: String, last: Int, before: String): EmployeeConnection! @cache(ttl: 30)
+  employee(id: Int!): Employee @cache(ttl: 30)
+  signIn(email: String!, password: String!): AuthPayload! @rateLimit(limit: 5, window: "1m")
 
 
-  projects(filter: ProjectFilter): [Project!]!
-  project(id: Int!): Project
+  projects(filter: ProjectFilter, sort: [SortInput!], first: Int, after: String, last: Int, before: String): ProjectConnection! @cache(ttl: 30)
+  project(id: Int!): Project @cache(ttl: 30)
 
 
-  tasks(filter: TaskFilter): [Task!]!
-  task(id: Int!): Task
+  tasks(filter: TaskFilter, sort: [SortInput!], first: Int, after: String, last: Int, before: String): TaskConnection! @auth(role: EMPLOYEE)
+  task(id: Int!): Task @auth(role: EMPLOYEE)
 
 
-  tickets(filter: TicketFilter): [Ticket!]!
-  ticket(id: Int!): Ticket
+  tickets(filter: TicketFilter, sort: [SortInput!], first: Int, after: String, last: Int, before: String): TicketConnection! @auth(role: EMPLOYEE)
+  ticket(id: Int!): Ticket @auth(role: EMPLOYEE)
+  workflow(projectID: Int @constraint(min: 1)): Workflow! @auth(role: EMPLOYEE)
 
 
-  teams(filter: TeamFilter): [Team!]!
-  team(id: Int!): Team
+  teams(filter: TeamFilter, sort: [SortInput!], first: Int, after: String, last: Int, before: String): TeamConnection! @cache(ttl: 30)
+  team(id: Int!): Team @cache(ttl: 30)
 
-  
-  notifications(employeeID: Int!, filter: NotificationFilter): [Notification!]!
 
-  teamEngineers(teamID: Int!): [TeamEngineer!]!
-  projectTeams(projectID: Int!): [ProjectTeam!]!
-  projectEmployees(projectID: Int!): [ProjectEmployee!]!
+  # Deleted rows that can still be restored
+  trashedEmployees(sort: [SortInput!], first: Int, after: String, last: Int, before: String): EmployeeConnection! @auth(role: ADMIN)
+  trashedProjects(sort: [SortInput!], first: Int, after: String, last: Int, before: String): ProjectConnection! @auth(role: MANAGER)
+  trashedTeams(sort: [SortInput!], first: Int, after: String, last: Int, before: String): TeamConnection! @auth(role: MANAGER)
+  trashedTickets(sort: [SortInput!], first: Int, after: String, last: Int, before: String): TicketConnection! @auth(role: TL)
+  trashedTasks(sort: [SortInput!], first: Int, after: String, last: Int, before: String): TaskConnection! @auth(role: TL)
+
+  auditLog(filter: AuditLogFilter, sort: [SortInput!], first: Int, after: String, last: Int, before: String): AuditEntryConnection! @auth(role: ADMIN)
+
+  notifications(employeeID: Int!, filter: NotificationFilter, sort: [SortInput!], first: Int, after: String, last: Int, before: String): NotificationConnection! @auth(role: EMPLOYEE)
+
+  teamEngineers(teamID: Int!): [TeamEngineer!]! @cache(ttl: 30)
+  projectTeams(projectID: Int!): [ProjectTeam!]! @cache(ttl: 30)
+  projectEmployees(projectID: Int!): [ProjectEmployee!]! @cache(ttl: 30)
   
-  employeesByProject(projectID: Int!): [Employee!]!
-  teamsByProject(projectID: Int!): [Team!]!
-  engineersByTeam(teamID: Int!): [Employee!]!
+  employeesByProject(projectID: Int!): [Employee!]! @cache(ttl: 30)
+  teamsByProject(projectID: Int!): [Team!]! @cache(ttl: 30)
+  engineersByTeam(teamID: Int!): [Employee!]! @cache(ttl: 30)
 
 
 
@@ -1380,6 +2771,9 @@ type Query {
 	{Name: "../graphqls/types.graphqls", Input: `# ----------- Scalars -----------
 scalar Date
 scalar DateTime
+scalar Map
+# Markdown source, stored as written; clients render it and must sanitise the HTML
+scalar Markdown
 
 # ----------- Enums -----------
 enum Role {
@@ -1404,6 +2798,12 @@ enum Priority {
   URGENT
 }
 
+enum AuditOperation {
+  CREATE
+  UPDATE
+  DELETE
+}
+
 enum NotificationType {
   INFO
   WARNING
@@ -1423,6 +2823,10 @@ type Employee {
   projectAssignedID: Int
   createdAt: DateTime!
   updatedAt: DateTime!
+  # Set while the row is in the trash
+  deletedAt: DateTime
+  # Projects the employee is a member of
+  projects: [Project!]!
 }
 
 type Project {
@@ -1434,6 +2838,14 @@ type Project {
   startDate: DateTime!
   createdAt: DateTime!
   updatedAt: DateTime!
+  # Set while the row is in the trash
+  deletedAt: DateTime
+  manager: Employee
+  teams: [Team!]!
+  # Scoped to the caller like the tickets query
+  tickets: [Ticket!]!
+  # Changes recorded in the audit log, oldest first. Only the project's manager reads them.
+  history: [AuditEntry!]! @auth(role: MANAGER)
 }
 
 type Team {
@@ -1443,6 +2855,10 @@ type Team {
   description: String
   createdAt: DateTime!
   updatedAt: DateTime!
+  # Set while the row is in the trash
+  deletedAt: DateTime
+  leader: Employee
+  engineers: [Employee!]!
 }
 
 type Ticket {
@@ -1455,6 +2871,13 @@ type Ticket {
   priority: Priority!
   createdAt: DateTime!
   completedAt: DateTime
+  # Set while the row is in the trash
+  deletedAt: DateTime
+  assignee: Employee
+  # Changes recorded in the audit log, oldest first, for those who can see the work
+  history: [AuditEntry!]! @auth
+  # Top-level comments; replies hang off each comment
+  comments(sort: [SortInput!], first: Int, after: String, last: Int, before: String): CommentConnection!
 }
 
 type Task {
@@ -1468,6 +2891,39 @@ type Task {
   priority: Priority!
   createdAt: DateTime!
   completedAt: DateTime
+  # Set while the row is in the trash
+  deletedAt: DateTime
+  # Changes recorded in the audit log, oldest first, for those who can see the work
+  history: [AuditEntry!]! @auth
+  # Top-level comments; replies hang off each comment
+  comments(sort: [SortInput!], first: Int, after: String, last: Int, before: String): CommentConnection!
+}
+
+# A comment on a ticket or a task. Replies are on the same ticket or task as the comment
+# they answer.
+type Comment {
+  id: Int!
+  ticketID: Int
+  taskID: Int
+  parentID: Int
+  authorID: Int
+  author: Employee
+  body: Markdown!
+  createdAt: DateTime!
+  updatedAt: DateTime!
+  # Set once the body has been edited
+  editedAt: DateTime
+  # Direct replies, oldest first
+  replies: [Comment!]!
+  # Earlier bodies, oldest first
+  revisions: [CommentRevision!]!
+}
+
+# The body a comment had until it was edited at createdAt
+type CommentRevision {
+  id: Int!
+  body: Markdown!
+  createdAt: DateTime!
 }
 
 type Notification {
@@ -1498,6 +2954,55 @@ type ProjectEmployee {
   createdAt: DateTime!
 }
 
+# One create, update or delete. before and after hold only the changed columns, keyed by
+# column name; entityID joins composite keys with ":".
+type AuditEntry {
+  id: Int!
+  actorID: Int
+  actor: Employee
+  entityType: String!
+  entityID: String!
+  operation: AuditOperation!
+  before: Map
+  after: Map
+  requestID: String!
+  createdAt: DateTime!
+}
+
+# The statuses tickets and tasks of a project may be in and the moves between them
+type Workflow {
+  projectID: Int
+  isDefault: Boolean!
+  states: [Status!]!
+  transitions: [WorkflowTransition!]!
+}
+
+type WorkflowTransition {
+  from: Status!
+  to: Status!
+  requiredRole: Role
+}
+
+# Rows purgeTrash removed for good, all deleted before cutoff. Memberships and the
+# tickets and tasks of purged projects go with them and are not counted.
+type PurgeResult {
+  cutoff: DateTime!
+  employees: Int!
+  projects: Int!
+  teams: Int!
+  tickets: Int!
+  tasks: Int!
+  comments: Int!
+}
+
+type AuthPayload {
+  accessToken: String!
+  expiresAt: DateTime!
+  refreshToken: String!
+  refreshTokenExpiresAt: DateTime!
+  employee: Employee!
+}
+
 
 
 # ----------- Inputs -----------
@@ -1509,61 +3014,126 @@ input LoginDetailsInput {
 }
 
 input EmployeeInput {
-  name: String!
+  name: String! @constraint(minLength: 1, maxLength: 100)
   role: Role!
-  email: String!
-  password: String!
-  projectID: Int
+  email: String! @constraint(format: "email", maxLength: 254)
+  password: String! @constraint(maxLength: 72)
+  projectID: Int @constraint(min: 1)
+  active: Boolean
 }
 
 input ProjectInput {
-  name: String!
-  managerID: Int
-  teamIDs: [Int!]
+  name: String! @constraint(minLength: 1, maxLength: 100)
+  managerID: Int @constraint(min: 1)
+  teamIDs: [Int!] @constraint(min: 1)
   status: Status
-  description: String
+  description: String @constraint(maxLength: 5000)
 }
 
 input TeamInput {
-  name: String!
-  teamLeaderID: Int
-  description: String
-  engineerIDs: [Int!]
+  name: String! @constraint(minLength: 1, maxLength: 100)
+  teamLeaderID: Int @constraint(min: 1)
+  description: String @constraint(maxLength: 5000)
+  engineerIDs: [Int!] @constraint(min: 1)
 }
 
 input TicketInput {
-  title: String!
-  description: String
-  projectID: Int!
-  assignedToID: Int
+  title: String! @constraint(minLength: 1, maxLength: 200)
+  description: String @constraint(maxLength: 5000)
+  projectID: Int! @constraint(min: 1)
+  assignedToID: Int @constraint(min: 1)
   status: Status!
   priority: Priority
 }
 
 input TaskInput {
-  title: String!
-  description: String
-  assignedToID: Int
-  projectID: Int
-  dueDate: Date
+  title: String! @constraint(minLength: 1, maxLength: 200)
+  description: String @constraint(maxLength: 5000)
+  assignedToID: Int @constraint(min: 1)
+  projectID: Int @constraint(min: 1)
+  dueDate: Date @constraint(format: "date")
   status: Status!
   priority: Priority
 }
 
+# Patch inputs update only the fields present in the request; an explicit null clears an
+# optional value. Fields whose column cannot be empty reject null.
+
+input EmployeePatchInput {
+  name: String @constraint(notNull: true, minLength: 1, maxLength: 100)
+  role: Role @constraint(notNull: true)
+  email: String @constraint(notNull: true, format: "email", maxLength: 254)
+  password: String @constraint(notNull: true, maxLength: 72)
+  projectID: Int @constraint(min: 1)
+  active: Boolean @constraint(notNull: true)
+}
+
+input ProjectPatchInput {
+  name: String @constraint(notNull: true, minLength: 1, maxLength: 100)
+  managerID: Int @constraint(min: 1)
+  status: Status @constraint(notNull: true)
+  description: String @constraint(maxLength: 5000)
+}
+
+input TeamPatchInput {
+  name: String @constraint(notNull: true, minLength: 1, maxLength: 100)
+  teamLeaderID: Int @constraint(min: 1)
+  description: String @constraint(maxLength: 5000)
+}
+
+input TicketPatchInput {
+  title: String @constraint(notNull: true, minLength: 1, maxLength: 200)
+  description: String @constraint(maxLength: 5000)
+  projectID: Int @constraint(notNull: true, min: 1)
+  assignedToID: Int @constraint(min: 1)
+  status: Status @constraint(notNull: true)
+  priority: Priority @constraint(notNull: true)
+}
+
+input TaskPatchInput {
+  title: String @constraint(notNull: true, minLength: 1, maxLength: 200)
+  description: String @constraint(maxLength: 5000)
+  assignedToID: Int @constraint(min: 1)
+  projectID: Int @constraint(min: 1)
+  dueDate: Date @constraint(format: "date")
+  status: Status @constraint(notNull: true)
+  priority: Priority @constraint(notNull: true)
+}
+
 input TeamEngineerInput {
-  teamID: Int!
-  engineerID: Int!
+  teamID: Int! @constraint(min: 1)
+  engineerID: Int! @constraint(min: 1)
 }
 
 input ProjectTeamInput {
-  projectID: Int!
-  teamID: Int!
+  projectID: Int! @constraint(min: 1)
+  teamID: Int! @constraint(min: 1)
 }
 
 input ProjectEmployeeInput {
-  projectID: Int!
-  employeeID: Int!
-  role: String!
+  projectID: Int! @constraint(min: 1)
+  employeeID: Int! @constraint(min: 1)
+  role: String! @constraint(oneOf: ["MEMBER", "LEAD"])
+}
+
+# A comment names the ticket or task it is on, or the comment it replies to; exactly one
+# of the three
+input CommentInput {
+  ticketID: Int @constraint(min: 1)
+  taskID: Int @constraint(min: 1)
+  parentID: Int @constraint(min: 1)
+  body: Markdown! @constraint(minLength: 1, maxLength: 10000)
+}
+
+input WorkflowInput {
+  states: [Status!]!
+  transitions: [WorkflowTransitionInput!]!
+}
+
+input WorkflowTransitionInput {
+  from: Status!
+  to: Status!
+  requiredRole: Role
 }
 `, BuiltIn: false},
 }
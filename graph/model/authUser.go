@@ -1,6 +1,7 @@
 package model
 
 type AuthUser struct {
-	ID   string
-	Role Role
+	ID        int
+	Role      Role
+	SessionID int
 }
@@ -8,17 +8,113 @@ import (
 	"io"
 	"strconv"
 	"time"
+
+	"github.com/99designs/gqlgen/graphql"
 )
 
+type AuditEntry struct {
+	ID         int            `json:"id"`
+	ActorID    *int           `json:"actorID,omitempty"`
+	Actor      *Employee      `json:"actor,omitempty"`
+	EntityType string         `json:"entityType"`
+	EntityID   string         `json:"entityID"`
+	Operation  AuditOperation `json:"operation"`
+	Before     map[string]any `json:"before,omitempty"`
+	After      map[string]any `json:"after,omitempty"`
+	RequestID  string         `json:"requestID"`
+	CreatedAt  time.Time      `json:"createdAt"`
+}
+
+type AuditEntryConnection struct {
+	Edges      []*AuditEntryEdge `json:"edges"`
+	PageInfo   *PageInfo         `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
+}
+
+type AuditEntryEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   *AuditEntry `json:"node"`
+}
+
+type AuditLogFilter struct {
+	ActorID         *int            `json:"actorID,omitempty"`
+	EntityType      *string         `json:"entityType,omitempty"`
+	EntityID        *string         `json:"entityID,omitempty"`
+	Operation       *AuditOperation `json:"operation,omitempty"`
+	RequestID       *string         `json:"requestID,omitempty"`
+	CreatedAtAfter  *time.Time      `json:"createdAtAfter,omitempty"`
+	CreatedAtBefore *time.Time      `json:"createdAtBefore,omitempty"`
+}
+
+type AuthPayload struct {
+	AccessToken           string    `json:"accessToken"`
+	ExpiresAt             time.Time `json:"expiresAt"`
+	RefreshToken          string    `json:"refreshToken"`
+	RefreshTokenExpiresAt time.Time `json:"refreshTokenExpiresAt"`
+	Employee              *Employee `json:"employee"`
+}
+
+type Comment struct {
+	ID        int                `json:"id"`
+	TicketID  *int               `json:"ticketID,omitempty"`
+	TaskID    *int               `json:"taskID,omitempty"`
+	ParentID  *int               `json:"parentID,omitempty"`
+	AuthorID  *int               `json:"authorID,omitempty"`
+	Author    *Employee          `json:"author,omitempty"`
+	Body      string             `json:"body"`
+	CreatedAt time.Time          `json:"createdAt"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+	EditedAt  *time.Time         `json:"editedAt,omitempty"`
+	Replies   []*Comment         `json:"replies"`
+	Revisions []*CommentRevision `json:"revisions"`
+}
+
+type CommentConnection struct {
+	Edges      []*CommentEdge `json:"edges"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+type CommentEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *Comment `json:"node"`
+}
+
+type CommentInput struct {
+	TicketID *int   `json:"ticketID,omitempty"`
+	TaskID   *int   `json:"taskID,omitempty"`
+	ParentID *int   `json:"parentID,omitempty"`
+	Body     string `json:"body"`
+}
+
+type CommentRevision struct {
+	ID        int       `json:"id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
 type Employee struct {
-	ID                int       `json:"id"`
-	Name              string    `json:"name"`
-	Email             string    `json:"email"`
-	Role              Role      `json:"role"`
-	Active            bool      `json:"active"`
-	ProjectAssignedID *int      `json:"projectAssignedID,omitempty"`
-	CreatedAt         time.Time `json:"createdAt"`
-	UpdatedAt         time.Time `json:"updatedAt"`
+	ID                int        `json:"id"`
+	Name              string     `json:"name"`
+	Email             string     `json:"email"`
+	Role              Role       `json:"role"`
+	Active            bool       `json:"active"`
+	ProjectAssignedID *int       `json:"projectAssignedID,omitempty"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	UpdatedAt         time.Time  `json:"updatedAt"`
+	DeletedAt         *time.Time `json:"deletedAt,omitempty"`
+	Projects          []*Project `json:"projects"`
+}
+
+type EmployeeConnection struct {
+	Edges      []*EmployeeEdge `json:"edges"`
+	PageInfo   *PageInfo       `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
+}
+
+type EmployeeEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *Employee `json:"node"`
 }
 
 type EmployeeFilter struct {
@@ -37,6 +133,16 @@ type EmployeeInput struct {
 	Email     string `json:"email"`
 	Password  string `json:"password"`
 	ProjectID *int   `json:"projectID,omitempty"`
+	Active    *bool  `json:"active,omitempty"`
+}
+
+type EmployeePatchInput struct {
+	Name      graphql.Omittable[*string] `json:"name,omitempty"`
+	Role      graphql.Omittable[*Role]   `json:"role,omitempty"`
+	Email     graphql.Omittable[*string] `json:"email,omitempty"`
+	Password  graphql.Omittable[*string] `json:"password,omitempty"`
+	ProjectID graphql.Omittable[*int]    `json:"projectID,omitempty"`
+	Active    graphql.Omittable[*bool]   `json:"active,omitempty"`
 }
 
 type LoginDetailsInput struct {
@@ -56,6 +162,17 @@ type Notification struct {
 	Read       bool             `json:"read"`
 }
 
+type NotificationConnection struct {
+	Edges      []*NotificationEdge `json:"edges"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
+}
+
+type NotificationEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *Notification `json:"node"`
+}
+
 type NotificationFilter struct {
 	Type            *NotificationType `json:"type,omitempty"`
 	Read            *bool             `json:"read,omitempty"`
@@ -63,15 +180,38 @@ type NotificationFilter struct {
 	CreatedAtBefore *time.Time        `json:"createdAtBefore,omitempty"`
 }
 
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+}
+
 type Project struct {
-	ID          int       `json:"id"`
-	ManagerID   *int      `json:"managerID,omitempty"`
-	Name        string    `json:"name"`
-	Status      Status    `json:"status"`
-	Description *string   `json:"description,omitempty"`
-	StartDate   time.Time `json:"startDate"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+	ID          int           `json:"id"`
+	ManagerID   *int          `json:"managerID,omitempty"`
+	Name        string        `json:"name"`
+	Status      Status        `json:"status"`
+	Description *string       `json:"description,omitempty"`
+	StartDate   time.Time     `json:"startDate"`
+	CreatedAt   time.Time     `json:"createdAt"`
+	UpdatedAt   time.Time     `json:"updatedAt"`
+	DeletedAt   *time.Time    `json:"deletedAt,omitempty"`
+	Manager     *Employee     `json:"manager,omitempty"`
+	Teams       []*Team       `json:"teams"`
+	Tickets     []*Ticket     `json:"tickets"`
+	History     []*AuditEntry `json:"history"`
+}
+
+type ProjectConnection struct {
+	Edges      []*ProjectEdge `json:"edges"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+type ProjectEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *Project `json:"node"`
 }
 
 type ProjectEmployee struct {
@@ -105,6 +245,13 @@ type ProjectInput struct {
 	Description *string `json:"description,omitempty"`
 }
 
+type ProjectPatchInput struct {
+	Name        graphql.Omittable[*string] `json:"name,omitempty"`
+	ManagerID   graphql.Omittable[*int]    `json:"managerID,omitempty"`
+	Status      graphql.Omittable[*Status] `json:"status,omitempty"`
+	Description graphql.Omittable[*string] `json:"description,omitempty"`
+}
+
 type ProjectTeam struct {
 	ProjectID int       `json:"projectID"`
 	TeamID    int       `json:"teamID"`
@@ -116,6 +263,16 @@ type ProjectTeamInput struct {
 	TeamID    int `json:"teamID"`
 }
 
+type PurgeResult struct {
+	Cutoff    time.Time `json:"cutoff"`
+	Employees int       `json:"employees"`
+	Projects  int       `json:"projects"`
+	Teams     int       `json:"teams"`
+	Tickets   int       `json:"tickets"`
+	Tasks     int       `json:"tasks"`
+	Comments  int       `json:"comments"`
+}
+
 type Query struct {
 }
 
@@ -125,16 +282,30 @@ type SortInput struct {
 }
 
 type Task struct {
-	ID           int        `json:"id"`
-	Title        string     `json:"title"`
-	Description  *string    `json:"description,omitempty"`
-	AssignedToID *int       `json:"assignedToID,omitempty"`
-	ProjectID    *int       `json:"projectID,omitempty"`
-	DueDate      *string    `json:"dueDate,omitempty"`
-	Status       Status     `json:"status"`
-	Priority     Priority   `json:"priority"`
-	CreatedAt    time.Time  `json:"createdAt"`
-	CompletedAt  *time.Time `json:"completedAt,omitempty"`
+	ID           int                `json:"id"`
+	Title        string             `json:"title"`
+	Description  *string            `json:"description,omitempty"`
+	AssignedToID *int               `json:"assignedToID,omitempty"`
+	ProjectID    *int               `json:"projectID,omitempty"`
+	DueDate      *string            `json:"dueDate,omitempty"`
+	Status       Status             `json:"status"`
+	Priority     Priority           `json:"priority"`
+	CreatedAt    time.Time          `json:"createdAt"`
+	CompletedAt  *time.Time         `json:"completedAt,omitempty"`
+	DeletedAt    *time.Time         `json:"deletedAt,omitempty"`
+	History      []*AuditEntry      `json:"history"`
+	Comments     *CommentConnection `json:"comments"`
+}
+
+type TaskConnection struct {
+	Edges      []*TaskEdge `json:"edges"`
+	PageInfo   *PageInfo   `json:"pageInfo"`
+	TotalCount int         `json:"totalCount"`
+}
+
+type TaskEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Task  `json:"node"`
 }
 
 type TaskFilter struct {
@@ -159,13 +330,37 @@ type TaskInput struct {
 	Priority     *Priority `json:"priority,omitempty"`
 }
 
+type TaskPatchInput struct {
+	Title        graphql.Omittable[*string]   `json:"title,omitempty"`
+	Description  graphql.Omittable[*string]   `json:"description,omitempty"`
+	AssignedToID graphql.Omittable[*int]      `json:"assignedToID,omitempty"`
+	ProjectID    graphql.Omittable[*int]      `json:"projectID,omitempty"`
+	DueDate      graphql.Omittable[*string]   `json:"dueDate,omitempty"`
+	Status       graphql.Omittable[*Status]   `json:"status,omitempty"`
+	Priority     graphql.Omittable[*Priority] `json:"priority,omitempty"`
+}
+
 type Team struct {
-	ID           int       `json:"id"`
-	TeamLeaderID *int      `json:"teamLeaderID,omitempty"`
-	Name         string    `json:"name"`
-	Description  *string   `json:"description,omitempty"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	ID           int         `json:"id"`
+	TeamLeaderID *int        `json:"teamLeaderID,omitempty"`
+	Name         string      `json:"name"`
+	Description  *string     `json:"description,omitempty"`
+	CreatedAt    time.Time   `json:"createdAt"`
+	UpdatedAt    time.Time   `json:"updatedAt"`
+	DeletedAt    *time.Time  `json:"deletedAt,omitempty"`
+	Leader       *Employee   `json:"leader,omitempty"`
+	Engineers    []*Employee `json:"engineers"`
+}
+
+type TeamConnection struct {
+	Edges      []*TeamEdge `json:"edges"`
+	PageInfo   *PageInfo   `json:"pageInfo"`
+	TotalCount int         `json:"totalCount"`
+}
+
+type TeamEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Team  `json:"node"`
 }
 
 type TeamEngineer struct {
@@ -193,16 +388,37 @@ type TeamInput struct {
 	EngineerIDs  []int   `json:"engineerIDs,omitempty"`
 }
 
+type TeamPatchInput struct {
+	Name         graphql.Omittable[*string] `json:"name,omitempty"`
+	TeamLeaderID graphql.Omittable[*int]    `json:"teamLeaderID,omitempty"`
+	Description  graphql.Omittable[*string] `json:"description,omitempty"`
+}
+
 type Ticket struct {
-	ID           int        `json:"id"`
-	ProjectID    int        `json:"projectID"`
-	AssignedToID *int       `json:"assignedToID,omitempty"`
-	Status       Status     `json:"status"`
-	Title        string     `json:"title"`
-	Description  *string    `json:"description,omitempty"`
-	Priority     Priority   `json:"priority"`
-	CreatedAt    time.Time  `json:"createdAt"`
-	CompletedAt  *time.Time `json:"completedAt,omitempty"`
+	ID           int                `json:"id"`
+	ProjectID    int                `json:"projectID"`
+	AssignedToID *int               `json:"assignedToID,omitempty"`
+	Status       Status             `json:"status"`
+	Title        string             `json:"title"`
+	Description  *string            `json:"description,omitempty"`
+	Priority     Priority           `json:"priority"`
+	CreatedAt    time.Time          `json:"createdAt"`
+	CompletedAt  *time.Time         `json:"completedAt,omitempty"`
+	DeletedAt    *time.Time         `json:"deletedAt,omitempty"`
+	Assignee     *Employee          `json:"assignee,omitempty"`
+	History      []*AuditEntry      `json:"history"`
+	Comments     *CommentConnection `json:"comments"`
+}
+
+type TicketConnection struct {
+	Edges      []*TicketEdge `json:"edges"`
+	PageInfo   *PageInfo     `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
+}
+
+type TicketEdge struct {
+	Cursor string  `json:"cursor"`
+	Node   *Ticket `json:"node"`
 }
 
 type TicketFilter struct {
@@ -224,6 +440,96 @@ type TicketInput struct {
 	Priority     *Priority `json:"priority,omitempty"`
 }
 
+type TicketPatchInput struct {
+	Title        graphql.Omittable[*string]   `json:"title,omitempty"`
+	Description  graphql.Omittable[*string]   `json:"description,omitempty"`
+	ProjectID    graphql.Omittable[*int]      `json:"projectID,omitempty"`
+	AssignedToID graphql.Omittable[*int]      `json:"assignedToID,omitempty"`
+	Status       graphql.Omittable[*Status]   `json:"status,omitempty"`
+	Priority     graphql.Omittable[*Priority] `json:"priority,omitempty"`
+}
+
+type Workflow struct {
+	ProjectID   *int                  `json:"projectID,omitempty"`
+	IsDefault   bool                  `json:"isDefault"`
+	States      []Status              `json:"states"`
+	Transitions []*WorkflowTransition `json:"transitions"`
+}
+
+type WorkflowInput struct {
+	States      []Status                   `json:"states"`
+	Transitions []*WorkflowTransitionInput `json:"transitions"`
+}
+
+type WorkflowTransition struct {
+	From         Status `json:"from"`
+	To           Status `json:"to"`
+	RequiredRole *Role  `json:"requiredRole,omitempty"`
+}
+
+type WorkflowTransitionInput struct {
+	From         Status `json:"from"`
+	To           Status `json:"to"`
+	RequiredRole *Role  `json:"requiredRole,omitempty"`
+}
+
+type AuditOperation string
+
+const (
+	AuditOperationCreate AuditOperation = "CREATE"
+	AuditOperationUpdate AuditOperation = "UPDATE"
+	AuditOperationDelete AuditOperation = "DELETE"
+)
+
+var AllAuditOperation = []AuditOperation{
+	AuditOperationCreate,
+	AuditOperationUpdate,
+	AuditOperationDelete,
+}
+
+func (e AuditOperation) IsValid() bool {
+	switch e {
+	case AuditOperationCreate, AuditOperationUpdate, AuditOperationDelete:
+		return true
+	}
+	return false
+}
+
+func (e AuditOperation) String() string {
+	return string(e)
+}
+
+func (e *AuditOperation) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditOperation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditOperation", str)
+	}
+	return nil
+}
+
+func (e AuditOperation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AuditOperation) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AuditOperation) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type NotificationType string
 
 const (
@@ -0,0 +1,19 @@
+package model
+
+// roleRank orders roles from least to most privileged: ADMIN > MANAGER > TL > EMPLOYEE
+var roleRank = map[Role]int{
+	RoleEmployee: 1,
+	RoleTl:       2,
+	RoleManager:  3,
+	RoleAdmin:    4,
+}
+
+// Rank returns the position of the role in the hierarchy, or 0 for an unknown role
+func (e Role) Rank() int {
+	return roleRank[e]
+}
+
+// AtLeast reports whether the role is the same as or outranks other
+func (e Role) AtLeast(other Role) bool {
+	return e.Rank() > 0 && e.Rank() >= other.Rank()
+}
@@ -0,0 +1,37 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+)
+
+// checkCommentTarget checks that the caller can see the ticket or task comments are on;
+// comments are never readable or writable past the visibility of their work
+func (r *Resolver) checkCommentTarget(ctx context.Context, ticketID *int, taskID *int) error {
+	user := middleware.GetUserFromContext(ctx)
+	if ticketID != nil {
+		if _, err := r.Repos.Tickets.GetVisible(ctx, user, *ticketID); err != nil {
+			return failure("ticket not found", err)
+		}
+	}
+	if taskID != nil {
+		if _, err := r.Repos.Tasks.GetVisible(ctx, user, *taskID); err != nil {
+			return failure("task not found", err)
+		}
+	}
+	return nil
+}
+
+// visibleComment loads a comment on a ticket or task the caller can see
+func (r *Resolver) visibleComment(ctx context.Context, id int) (*db.Comment, error) {
+	comment, err := r.Repos.Comments.Get(ctx, id)
+	if err != nil {
+		return nil, failure("comment not found", err)
+	}
+	if err := r.checkCommentTarget(ctx, comment.TicketID, comment.TaskID); err != nil {
+		return nil, failure("comment not found", err)
+	}
+	return comment, nil
+}
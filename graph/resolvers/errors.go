@@ -0,0 +1,146 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/JonJenson-MFIn/project-management-system-api/auth"
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/logging"
+	"github.com/JonJenson-MFIn/project-management-system-api/policy"
+	"github.com/JonJenson-MFIn/project-management-system-api/repository"
+	"github.com/JonJenson-MFIn/project-management-system-api/workflow"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Codes set as extensions.code on every error the API returns, so clients can branch on
+// them instead of matching messages
+const (
+	CodeNotFound          = "NOT_FOUND"
+	CodeForbidden         = "FORBIDDEN"
+	CodeUnauthenticated   = "UNAUTHENTICATED"
+	CodeValidationFailed  = "VALIDATION_FAILED"
+	CodeConflict          = "CONFLICT"
+	CodeInvalidTransition = "INVALID_TRANSITION"
+	CodeInternal          = "INTERNAL"
+)
+
+// codes maps the domain errors a client can act on to their code; their messages are
+// safe to return. Anything else is internal.
+var codes = []struct {
+	err  error
+	code string
+}{
+	{repository.ErrNotFound, CodeNotFound},
+	{policy.ErrForbidden, CodeForbidden},
+	{auth.ErrUnauthenticated, CodeUnauthenticated},
+	{auth.ErrInvalidCredentials, CodeUnauthenticated},
+	{auth.ErrInvalidRefreshToken, CodeUnauthenticated},
+	{auth.ErrSessionRevoked, CodeUnauthenticated},
+	{auth.ErrAccountDeactivated, CodeUnauthenticated},
+	{auth.ErrIncorrectPassword, CodeValidationFailed},
+	{db.ErrInvalidInput, CodeValidationFailed},
+	{db.ErrConflict, CodeConflict},
+	{workflow.ErrInvalidTransition, CodeInvalidTransition},
+}
+
+func codeOf(err error) string {
+	for _, c := range codes {
+		if errors.Is(err, c.err) {
+			return c.code
+		}
+	}
+	return CodeInternal
+}
+
+// signInRejected logs why a sign in failed and returns the one error every failure gets,
+// so callers cannot tell which emails exist or which accounts are deactivated
+func signInRejected(ctx context.Context, reason string, attrs ...any) error {
+	slog.WarnContext(ctx, "sign in rejected", append([]any{"reason", reason}, attrs...)...)
+	return auth.ErrInvalidCredentials
+}
+
+// failedError is a resolver failure: message says what failed and err why
+type failedError struct {
+	message string
+	err     error
+}
+
+// Error leaves out a bare sentinel cause such as "record not found"; the code says it
+func (e *failedError) Error() string {
+	for _, c := range codes {
+		if e.err == c.err {
+			return e.message
+		}
+	}
+	return e.message + ": " + e.err.Error()
+}
+
+func (e *failedError) Unwrap() error {
+	return e.err
+}
+
+// failure reports err under message. The presenter picks the code from err and, for
+// internal causes such as a raw database error, may show the client only message.
+func failure(message string, err error) error {
+	return &failedError{message: message, err: err}
+}
+
+// panicError is a recovered resolver panic
+type panicError struct {
+	value interface{}
+	stack []byte
+}
+
+func (e *panicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.value)
+}
+
+// Recover turns a resolver panic into an internal error; the presenter logs it with the
+// stack. Pass it to SetRecoverFunc.
+func Recover(ctx context.Context, value interface{}) error {
+	return &panicError{value: value, stack: debug.Stack()}
+}
+
+// ErrorPresenter sets extensions.code on every error without one. Internal errors are
+// logged with the request's correlation fields and carry the request ID; with
+// hideInternal the client gets only the resolver's summary, never the cause. Pass it to
+// SetErrorPresenter.
+func ErrorPresenter(hideInternal bool) graphql.ErrorPresenterFunc {
+	return func(ctx context.Context, err error) *gqlerror.Error {
+		presented := graphql.DefaultErrorPresenter(ctx, err)
+		if _, ok := presented.Extensions["code"]; ok {
+			return presented
+		}
+
+		code := codeOf(err)
+		if presented.Extensions == nil {
+			presented.Extensions = map[string]interface{}{}
+		}
+		presented.Extensions["code"] = code
+		if code != CodeInternal {
+			return presented
+		}
+
+		attrs := []any{"error", presented.Message, "path", presented.Path.String()}
+		var recovered *panicError
+		if errors.As(err, &recovered) {
+			attrs = append(attrs, "stack", string(recovered.stack))
+		}
+		slog.ErrorContext(ctx, "internal error", attrs...)
+
+		presented.Extensions["requestId"] = logging.RequestID(ctx)
+		if hideInternal {
+			presented.Message = "internal error"
+			var failed *failedError
+			if errors.As(err, &failed) {
+				presented.Message = failed.message
+			}
+		}
+		return presented
+	}
+}
@@ -0,0 +1,22 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"github.com/JonJenson-MFIn/project-management-system-api/policy"
+)
+
+// canViewHistory checks that the caller can see the ticket or task whose history they
+// read, the same way the tickets and tasks queries scope it
+func (r *Resolver) canViewHistory(ctx context.Context, kind string, id int, assignedToID *int, projectID *int) error {
+	ok, err := r.Policy.CanViewWork(ctx, middleware.GetUserFromContext(ctx), assignedToID, projectID)
+	if err != nil {
+		return failure(fmt.Sprintf("failed to check access to %s %d", kind, id), err)
+	}
+	if !ok {
+		return fmt.Errorf("%w: history of %s %d is not visible to you", policy.ErrForbidden, kind, id)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package resolvers
+
+import (
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"gorm.io/gorm"
+)
+
+// deletedAtToModel is nil for rows outside the trash
+func deletedAtToModel(deletedAt gorm.DeletedAt) *time.Time {
+	if !deletedAt.Valid {
+		return nil
+	}
+	return &deletedAt.Time
+}
+
+// employeeToModel converts a database employee to its GraphQL model
+func employeeToModel(employee *db.Employee) *model.Employee {
+	return &model.Employee{
+		ID:                employee.ID,
+		Name:              employee.Name,
+		Email:             employee.Email,
+		Role:              db.RoleToModel(employee.Role),
+		Active:            employee.Active,
+		ProjectAssignedID: employee.ProjectAssignedID,
+		CreatedAt:         employee.CreatedAt,
+		UpdatedAt:         employee.UpdatedAt,
+		DeletedAt:         deletedAtToModel(employee.DeletedAt),
+	}
+}
+
+// projectToModel converts a database project to its GraphQL model
+func projectToModel(project *db.Project) *model.Project {
+	return &model.Project{
+		ID:          project.ID,
+		ManagerID:   project.ManagerID,
+		Name:        project.Name,
+		Status:      db.StatusToModel(project.Status),
+		Description: project.Description,
+		StartDate:   project.StartDate,
+		CreatedAt:   project.CreatedAt,
+		UpdatedAt:   project.UpdatedAt,
+		DeletedAt:   deletedAtToModel(project.DeletedAt),
+	}
+}
+
+// teamToModel converts a database team to its GraphQL model
+func teamToModel(team *db.Team) *model.Team {
+	return &model.Team{
+		ID:           team.ID,
+		TeamLeaderID: team.TeamLeaderID,
+		Name:         team.Name,
+		Description:  team.Description,
+		CreatedAt:    team.CreatedAt,
+		UpdatedAt:    team.UpdatedAt,
+		DeletedAt:    deletedAtToModel(team.DeletedAt),
+	}
+}
+
+// ticketToModel converts a database ticket to its GraphQL model
+func ticketToModel(ticket *db.Ticket) *model.Ticket {
+	return &model.Ticket{
+		ID:           ticket.ID,
+		ProjectID:    ticket.ProjectID,
+		AssignedToID: ticket.AssignedToID,
+		Status:       db.StatusToModel(ticket.Status),
+		Title:        ticket.Title,
+		Description:  ticket.Description,
+		Priority:     model.Priority(ticket.Priority),
+		CreatedAt:    ticket.CreatedAt,
+		CompletedAt:  ticket.CompletedAt,
+		DeletedAt:    deletedAtToModel(ticket.DeletedAt),
+	}
+}
+
+// taskToModel converts a database task to its GraphQL model
+func taskToModel(task *db.Task) *model.Task {
+	var dueDate *string
+	if task.DueDate != nil {
+		formatted := task.DueDate.Format("2006-01-02")
+		dueDate = &formatted
+	}
+
+	return &model.Task{
+		ID:           task.ID,
+		Title:        task.Title,
+		Description:  task.Description,
+		AssignedToID: task.AssignedToID,
+		ProjectID:    task.ProjectID,
+		DueDate:      dueDate,
+		Status:       db.StatusToModel(task.Status),
+		Priority:     model.Priority(task.Priority),
+		CreatedAt:    task.CreatedAt,
+		CompletedAt:  task.CompletedAt,
+		DeletedAt:    deletedAtToModel(task.DeletedAt),
+	}
+}
+
+// notificationToModel converts a database notification to its GraphQL model
+func notificationToModel(notification *db.Notification) *model.Notification {
+	return &model.Notification{
+		ID:         notification.ID,
+		Message:    notification.Message,
+		EmployeeID: notification.EmployeeID,
+		Type:       model.NotificationType(notification.Type),
+		Read:       notification.Read,
+		CreatedAt:  notification.CreatedAt,
+	}
+}
+
+// teamEngineerToModel converts a database team membership to its GraphQL model
+func teamEngineerToModel(link *db.TeamEngineer) *model.TeamEngineer {
+	return &model.TeamEngineer{
+		TeamID:     link.TeamID,
+		EngineerID: link.EngineerID,
+		CreatedAt:  link.CreatedAt,
+	}
+}
+
+// projectTeamToModel converts a database project team link to its GraphQL model
+func projectTeamToModel(link *db.ProjectTeam) *model.ProjectTeam {
+	return &model.ProjectTeam{
+		ProjectID: link.ProjectID,
+		TeamID:    link.TeamID,
+		CreatedAt: link.CreatedAt,
+	}
+}
+
+// projectEmployeeToModel converts a database project membership to its GraphQL model
+func projectEmployeeToModel(link *db.ProjectEmployee) *model.ProjectEmployee {
+	return &model.ProjectEmployee{
+		ProjectID:  link.ProjectID,
+		EmployeeID: link.EmployeeID,
+		Role:       link.Role,
+		CreatedAt:  link.CreatedAt,
+	}
+}
+
+// workflowToModel converts a database workflow to its GraphQL model. The default
+// workflow is shared, so projectID says whose it is.
+func workflowToModel(workflow *db.Workflow, projectID *int) *model.Workflow {
+	result := &model.Workflow{
+		ProjectID: projectID,
+		IsDefault: workflow.ProjectID == 0,
+		States:    make([]model.Status, 0, len(workflow.States)),
+	}
+	for _, state := range workflow.States {
+		result.States = append(result.States, db.StatusToModel(state))
+	}
+	result.Transitions = make([]*model.WorkflowTransition, 0, len(workflow.Transitions))
+	for _, t := range workflow.Transitions {
+		transition := &model.WorkflowTransition{From: db.StatusToModel(t.From), To: db.StatusToModel(t.To)}
+		if t.RequiredRole != nil {
+			role := db.RoleToModel(*t.RequiredRole)
+			transition.RequiredRole = &role
+		}
+		result.Transitions = append(result.Transitions, transition)
+	}
+	return result
+}
+
+// auditEntryToModel converts a database audit entry to its GraphQL model
+func auditEntryToModel(entry *db.AuditEntry) *model.AuditEntry {
+	return &model.AuditEntry{
+		ID:         entry.ID,
+		ActorID:    entry.ActorID,
+		EntityType: entry.EntityType,
+		EntityID:   entry.EntityID,
+		Operation:  model.AuditOperation(entry.Operation),
+		Before:     entry.Before,
+		After:      entry.After,
+		RequestID:  entry.RequestID,
+		CreatedAt:  entry.CreatedAt,
+	}
+}
+
+// historyToModel converts the audit entries of one entity
+func historyToModel(entries []db.AuditEntry) []*model.AuditEntry {
+	result := make([]*model.AuditEntry, len(entries))
+	for i := range entries {
+		result[i] = auditEntryToModel(&entries[i])
+	}
+	return result
+}
+
+// commentToModel converts a database comment to its GraphQL model
+func commentToModel(comment *db.Comment) *model.Comment {
+	return &model.Comment{
+		ID:        comment.ID,
+		TicketID:  comment.TicketID,
+		TaskID:    comment.TaskID,
+		ParentID:  comment.ParentID,
+		AuthorID:  comment.AuthorID,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+		EditedAt:  comment.EditedAt,
+	}
+}
+
+// commentConnectionToModel converts a page of comments to its GraphQL connection
+func commentConnectionToModel(page *db.Page[db.Comment]) *model.CommentConnection {
+	edges := make([]*model.CommentEdge, len(page.Rows))
+	for i := range page.Rows {
+		edges[i] = &model.CommentEdge{Cursor: page.Cursors[i], Node: commentToModel(&page.Rows[i])}
+	}
+	return &model.CommentConnection{Edges: edges, PageInfo: page.PageInfo, TotalCount: page.TotalCount}
+}
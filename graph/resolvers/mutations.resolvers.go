@@ -9,94 +9,191 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/JonJenson-MFIn/project-management-system-api/auth"
 	"github.com/JonJenson-MFIn/project-management-system-api/db"
 	"github.com/JonJenson-MFIn/project-management-system-api/graph/generated"
 	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"github.com/JonJenson-MFIn/project-management-system-api/policy"
+	"github.com/JonJenson-MFIn/project-management-system-api/workflow"
 )
 
+// RefreshSession is the resolver for the refreshSession field.
+func (r *mutationResolver) RefreshSession(ctx context.Context, refreshToken string) (*model.AuthPayload, error) {
+	tokens, employee, err := r.Sessions.Refresh(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AuthPayload{
+		AccessToken:           tokens.AccessToken,
+		ExpiresAt:             tokens.AccessTokenExpiresAt,
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: tokens.RefreshTokenExpiresAt,
+		Employee:              employeeToModel(employee),
+	}, nil
+}
+
+// SignOut is the resolver for the signOut field.
+func (r *mutationResolver) SignOut(ctx context.Context) (bool, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return false, auth.ErrUnauthenticated
+	}
+
+	if err := r.Sessions.Revoke(ctx, user.SessionID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RevokeAllSessions is the resolver for the revokeAllSessions field.
+func (r *mutationResolver) RevokeAllSessions(ctx context.Context, employeeID int) (bool, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return false, auth.ErrUnauthenticated
+	}
+
+	// Employees may end their own sessions; ending anyone else's requires ADMIN
+	if user.ID != employeeID && user.Role != model.RoleAdmin {
+		return false, fmt.Errorf("%w: requires %s to revoke another employee's sessions", policy.ErrForbidden, model.RoleAdmin)
+	}
+
+	if _, err := r.Sessions.RevokeAll(ctx, employeeID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ChangePassword is the resolver for the changePassword field.
+func (r *mutationResolver) ChangePassword(ctx context.Context, oldPassword string, newPassword string) (bool, error) {
+	user := middleware.GetUserFromContext(ctx)
+	if user == nil {
+		return false, auth.ErrUnauthenticated
+	}
+
+	employee, err := r.Repos.Employees.Get(ctx, user.ID)
+	if err != nil {
+		return false, failure("employee not found", err)
+	}
+
+	if err := r.Passwords.Compare(employee.Password, oldPassword); err != nil {
+		return false, err
+	}
+
+	hashedPassword, err := r.Passwords.Hash(newPassword)
+	if err != nil {
+		return false, err
+	}
+
+	employee.Password = hashedPassword
+	if err := r.Repos.Employees.Save(ctx, employee); err != nil {
+		return false, failure("failed to change password", err)
+	}
+
+	// Sign out every other device that knew the old password
+	if _, err := r.Sessions.RevokeOthers(ctx, employee.ID, user.SessionID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 // AddEmployee is the resolver for the addEmployee field.
 func (r *mutationResolver) AddEmployee(ctx context.Context, input model.EmployeeInput) (*model.Employee, error) {
+	hashedPassword, err := r.Passwords.Hash(input.Password)
+	if err != nil {
+		return nil, err
+	}
+
 	newEmployee := db.Employee{
 		Name:              input.Name,
 		Email:             input.Email,
-		Password:          input.Password,
+		Password:          hashedPassword,
 		Role:              db.RoleToDB(input.Role),
 		Active:            true,
 		ProjectAssignedID: input.ProjectID,
 	}
 
-	if err := r.DB.Create(&newEmployee).Error; err != nil {
-		return nil, fmt.Errorf("failed to create employee: %w", err)
+	if err := r.Repos.Employees.Create(ctx, &newEmployee); err != nil {
+		return nil, failure("failed to create employee", err)
 	}
 
-	return &model.Employee{
-		ID:                newEmployee.ID,
-		Name:              newEmployee.Name,
-		Email:             newEmployee.Email,
-		Role:              db.RoleToModel(newEmployee.Role),
-		Active:            newEmployee.Active,
-		ProjectAssignedID: newEmployee.ProjectAssignedID,
-		CreatedAt:         newEmployee.CreatedAt,
-		UpdatedAt:         newEmployee.UpdatedAt,
-	}, nil
+	return employeeToModel(&newEmployee), nil
 }
 
 // UpdateEmployee is the resolver for the updateEmployee field.
-func (r *mutationResolver) UpdateEmployee(ctx context.Context, id int, input model.EmployeeInput) (*model.Employee, error) {
-	var employee db.Employee
-	if err := r.DB.Where("id = ?", id).First(&employee).Error; err != nil {
-		return nil, fmt.Errorf("employee not found: %w", err)
+func (r *mutationResolver) UpdateEmployee(ctx context.Context, id int, input model.EmployeePatchInput) (*model.Employee, error) {
+	employee, err := r.Repos.Employees.Get(ctx, id)
+	if err != nil {
+		return nil, failure("employee not found", err)
 	}
 
-	// Update fields if provided
-	if input.Name != "" {
-		employee.Name = input.Name
+	if name, ok := given(input.Name); ok {
+		employee.Name = name
 	}
-	if input.Email != "" {
-		employee.Email = input.Email
+	if email, ok := given(input.Email); ok {
+		employee.Email = email
 	}
-	if input.Password != "" {
-		employee.Password = input.Password
+	if password, ok := given(input.Password); ok {
+		hashedPassword, err := r.Passwords.Hash(password)
+		if err != nil {
+			return nil, err
+		}
+		employee.Password = hashedPassword
 	}
-	if input.Role != "" {
-		employee.Role = db.RoleToDB(input.Role)
+	if role, ok := given(input.Role); ok {
+		employee.Role = db.RoleToDB(role)
 	}
-	if input.ProjectID != nil {
-		employee.ProjectAssignedID = input.ProjectID
+	patch(&employee.ProjectAssignedID, input.ProjectID)
+	if active, ok := given(input.Active); ok {
+		employee.Active = active
 	}
 
-	if err := r.DB.Save(&employee).Error; err != nil {
-		return nil, fmt.Errorf("failed to update employee: %w", err)
+	// Saving a deactivated employee also ends their sessions
+	if err := r.Repos.Employees.Save(ctx, employee); err != nil {
+		return nil, failure("failed to update employee", err)
 	}
 
-	return &model.Employee{
-		ID:                employee.ID,
-		Name:              employee.Name,
-		Email:             employee.Email,
-		Role:              db.RoleToModel(employee.Role),
-		Active:            employee.Active,
-		ProjectAssignedID: employee.ProjectAssignedID,
-		CreatedAt:         employee.CreatedAt,
-		UpdatedAt:         employee.UpdatedAt,
-	}, nil
+	return employeeToModel(employee), nil
 }
 
 // DeleteEmployee is the resolver for the deleteEmployee field.
 func (r *mutationResolver) DeleteEmployee(ctx context.Context, id int) (bool, error) {
-	var employee db.Employee
-	if err := r.DB.Where("id = ?", id).First(&employee).Error; err != nil {
-		return false, fmt.Errorf("employee not found: %w", err)
+	employee, err := r.Repos.Employees.Get(ctx, id)
+	if err != nil {
+		return false, failure("employee not found", err)
 	}
 
-	if err := r.DB.Delete(&employee).Error; err != nil {
-		return false, fmt.Errorf("failed to delete employee: %w", err)
+	if err := r.Repos.Employees.Delete(ctx, employee); err != nil {
+		return false, failure("failed to delete employee", err)
 	}
 
 	return true, nil
 }
 
+// RestoreEmployee is the resolver for the restoreEmployee field.
+func (r *mutationResolver) RestoreEmployee(ctx context.Context, id int) (*model.Employee, error) {
+	employee, err := r.Repos.Employees.GetTrashed(ctx, id)
+	if err != nil {
+		return nil, failure("employee not found in the trash", err)
+	}
+
+	if err := r.Repos.Employees.Restore(ctx, employee); err != nil {
+		return nil, failure("failed to restore employee", err)
+	}
+
+	return employeeToModel(employee), nil
+}
+
 // AddProject is the resolver for the addProject field.
 func (r *mutationResolver) AddProject(ctx context.Context, input model.ProjectInput) (*model.Project, error) {
+	if err := r.Policy.CanCreateProject(ctx, middleware.GetUserFromContext(ctx), input.ManagerID); err != nil {
+		return nil, err
+	}
+
 	status := db.StatusNotStartedDB
 	if input.Status != nil {
 		status = db.StatusToDB(*input.Status)
@@ -110,139 +207,208 @@ func (r *mutationResolver) AddProject(ctx context.Context, input model.ProjectIn
 		StartDate:   time.Now(),
 	}
 
-	if err := r.DB.Create(&newProject).Error; err != nil {
-		return nil, fmt.Errorf("failed to create project: %w", err)
+	if err := r.Repos.Projects.Create(ctx, &newProject); err != nil {
+		return nil, failure("failed to create project", err)
 	}
 
-	return &model.Project{
-		ID:          newProject.ID,
-		ManagerID:   newProject.ManagerID,
-		Name:        newProject.Name,
-		Status:      db.StatusToModel(newProject.Status),
-		Description: newProject.Description,
-		StartDate:   newProject.StartDate,
-		CreatedAt:   newProject.CreatedAt,
-		UpdatedAt:   newProject.UpdatedAt,
-	}, nil
+	return projectToModel(&newProject), nil
 }
 
 // UpdateProject is the resolver for the updateProject field.
-func (r *mutationResolver) UpdateProject(ctx context.Context, id int, input model.ProjectInput) (*model.Project, error) {
-	var project db.Project
-	if err := r.DB.Where("id = ?", id).First(&project).Error; err != nil {
-		return nil, fmt.Errorf("project not found: %w", err)
+func (r *mutationResolver) UpdateProject(ctx context.Context, id int, input model.ProjectPatchInput) (*model.Project, error) {
+	project, err := r.Repos.Projects.Get(ctx, id)
+	if err != nil {
+		return nil, failure("project not found", err)
 	}
 
-	if input.Name != "" {
-		project.Name = input.Name
-	}
-	if input.ManagerID != nil {
-		project.ManagerID = input.ManagerID
+	if err := r.Policy.CanManageProject(ctx, middleware.GetUserFromContext(ctx), project); err != nil {
+		return nil, err
 	}
-	if input.Status != nil {
-		project.Status = db.StatusToDB(*input.Status)
+
+	if name, ok := given(input.Name); ok {
+		project.Name = name
 	}
-	if input.Description != nil {
-		project.Description = input.Description
+	patch(&project.ManagerID, input.ManagerID)
+	if status, ok := given(input.Status); ok {
+		project.Status = db.StatusToDB(status)
 	}
+	patch(&project.Description, input.Description)
 
-	if err := r.DB.Save(&project).Error; err != nil {
-		return nil, fmt.Errorf("failed to update project: %w", err)
+	if err := r.Repos.Projects.Save(ctx, project); err != nil {
+		return nil, failure("failed to update project", err)
 	}
 
-	return &model.Project{
-		ID:          project.ID,
-		ManagerID:   project.ManagerID,
-		Name:        project.Name,
-		Status:      db.StatusToModel(project.Status),
-		Description: project.Description,
-		StartDate:   project.StartDate,
-		CreatedAt:   project.CreatedAt,
-		UpdatedAt:   project.UpdatedAt,
-	}, nil
+	return projectToModel(project), nil
 }
 
 // DeleteProject is the resolver for the deleteProject field.
 func (r *mutationResolver) DeleteProject(ctx context.Context, id int) (bool, error) {
-	var project db.Project
-	if err := r.DB.Where("id = ?", id).First(&project).Error; err != nil {
-		return false, fmt.Errorf("project not found: %w", err)
+	project, err := r.Repos.Projects.Get(ctx, id)
+	if err != nil {
+		return false, failure("project not found", err)
+	}
+
+	if err := r.Policy.CanManageProject(ctx, middleware.GetUserFromContext(ctx), project); err != nil {
+		return false, err
 	}
 
-	if err := r.DB.Delete(&project).Error; err != nil {
-		return false, fmt.Errorf("failed to delete project: %w", err)
+	if err := r.Repos.Projects.Delete(ctx, project); err != nil {
+		return false, failure("failed to delete project", err)
 	}
 
 	return true, nil
 }
 
+// RestoreProject is the resolver for the restoreProject field.
+func (r *mutationResolver) RestoreProject(ctx context.Context, id int) (*model.Project, error) {
+	project, err := r.Repos.Projects.GetTrashed(ctx, id)
+	if err != nil {
+		return nil, failure("project not found in the trash", err)
+	}
+
+	if err := r.Policy.CanManageProject(ctx, middleware.GetUserFromContext(ctx), project); err != nil {
+		return nil, err
+	}
+
+	if err := r.Repos.Projects.Restore(ctx, project); err != nil {
+		return nil, failure("failed to restore project", err)
+	}
+
+	return projectToModel(project), nil
+}
+
+// SetWorkflow is the resolver for the setWorkflow field.
+func (r *mutationResolver) SetWorkflow(ctx context.Context, projectID int, input model.WorkflowInput) (*model.Workflow, error) {
+	project, err := r.Repos.Projects.Get(ctx, projectID)
+	if err != nil {
+		return nil, failure("project not found", err)
+	}
+
+	if err := r.Policy.CanManageProject(ctx, middleware.GetUserFromContext(ctx), project); err != nil {
+		return nil, err
+	}
+
+	definition := db.Workflow{ProjectID: project.ID, Transitions: []db.WorkflowTransition{}}
+	for _, state := range input.States {
+		definition.States = append(definition.States, db.StatusToDB(state))
+	}
+	for _, t := range input.Transitions {
+		transition := db.WorkflowTransition{From: db.StatusToDB(t.From), To: db.StatusToDB(t.To)}
+		if t.RequiredRole != nil {
+			role := db.RoleToDB(*t.RequiredRole)
+			transition.RequiredRole = &role
+		}
+		definition.Transitions = append(definition.Transitions, transition)
+	}
+
+	if err := workflow.Validate(&definition); err != nil {
+		return nil, failure("invalid workflow", err)
+	}
+
+	if err := r.Repos.Workflows.Save(ctx, &definition); err != nil {
+		return nil, failure("failed to save workflow", err)
+	}
+
+	return workflowToModel(&definition, &project.ID), nil
+}
+
+// ResetWorkflow is the resolver for the resetWorkflow field.
+func (r *mutationResolver) ResetWorkflow(ctx context.Context, projectID int) (*model.Workflow, error) {
+	project, err := r.Repos.Projects.Get(ctx, projectID)
+	if err != nil {
+		return nil, failure("project not found", err)
+	}
+
+	if err := r.Policy.CanManageProject(ctx, middleware.GetUserFromContext(ctx), project); err != nil {
+		return nil, err
+	}
+
+	if err := r.Repos.Workflows.Delete(ctx, project.ID); err != nil {
+		return nil, failure("failed to reset workflow", err)
+	}
+
+	return workflowToModel(&workflow.Default, &project.ID), nil
+}
+
 // AddTeam is the resolver for the addTeam field.
 func (r *mutationResolver) AddTeam(ctx context.Context, input model.TeamInput) (*model.Team, error) {
+	if err := r.Policy.CanCreateTeam(ctx, middleware.GetUserFromContext(ctx), input.TeamLeaderID); err != nil {
+		return nil, err
+	}
+
 	newTeam := db.Team{
 		Name:         input.Name,
 		TeamLeaderID: input.TeamLeaderID,
 		Description:  input.Description,
 	}
 
-	if err := r.DB.Create(&newTeam).Error; err != nil {
-		return nil, fmt.Errorf("failed to create team: %w", err)
+	if err := r.Repos.Teams.Create(ctx, &newTeam); err != nil {
+		return nil, failure("failed to create team", err)
 	}
 
-	return &model.Team{
-		ID:           newTeam.ID,
-		Name:         newTeam.Name,
-		TeamLeaderID: newTeam.TeamLeaderID,
-		Description:  newTeam.Description,
-		CreatedAt:    newTeam.CreatedAt,
-		UpdatedAt:    newTeam.UpdatedAt,
-	}, nil
+	return teamToModel(&newTeam), nil
 }
 
 // UpdateTeam is the resolver for the updateTeam field.
-func (r *mutationResolver) UpdateTeam(ctx context.Context, id int, input model.TeamInput) (*model.Team, error) {
-	var team db.Team
-	if err := r.DB.Where("id = ?", id).First(&team).Error; err != nil {
-		return nil, fmt.Errorf("team not found: %w", err)
+func (r *mutationResolver) UpdateTeam(ctx context.Context, id int, input model.TeamPatchInput) (*model.Team, error) {
+	team, err := r.Repos.Teams.Get(ctx, id)
+	if err != nil {
+		return nil, failure("team not found", err)
 	}
 
-	if input.Name != "" {
-		team.Name = input.Name
+	if err := r.Policy.CanManageTeam(ctx, middleware.GetUserFromContext(ctx), team); err != nil {
+		return nil, err
 	}
-	if input.TeamLeaderID != nil {
-		team.TeamLeaderID = input.TeamLeaderID
-	}
-	if input.Description != nil {
-		team.Description = input.Description
+
+	if name, ok := given(input.Name); ok {
+		team.Name = name
 	}
+	patch(&team.TeamLeaderID, input.TeamLeaderID)
+	patch(&team.Description, input.Description)
 
-	if err := r.DB.Save(&team).Error; err != nil {
-		return nil, fmt.Errorf("failed to update team: %w", err)
+	if err := r.Repos.Teams.Save(ctx, team); err != nil {
+		return nil, failure("failed to update team", err)
 	}
 
-	return &model.Team{
-		ID:           team.ID,
-		Name:         team.Name,
-		TeamLeaderID: team.TeamLeaderID,
-		Description:  team.Description,
-		CreatedAt:    team.CreatedAt,
-		UpdatedAt:    team.UpdatedAt,
-	}, nil
+	return teamToModel(team), nil
 }
 
 // DeleteTeam is the resolver for the deleteTeam field.
 func (r *mutationResolver) DeleteTeam(ctx context.Context, id int) (bool, error) {
-	var team db.Team
-	if err := r.DB.Where("id = ?", id).First(&team).Error; err != nil {
-		return false, fmt.Errorf("team not found: %w", err)
+	team, err := r.Repos.Teams.Get(ctx, id)
+	if err != nil {
+		return false, failure("team not found", err)
 	}
 
-	if err := r.DB.Delete(&team).Error; err != nil {
-		return false, fmt.Errorf("failed to delete team: %w", err)
+	if err := r.Policy.CanManageTeam(ctx, middleware.GetUserFromContext(ctx), team); err != nil {
+		return false, err
+	}
+
+	if err := r.Repos.Teams.Delete(ctx, team); err != nil {
+		return false, failure("failed to delete team", err)
 	}
 
 	return true, nil
 }
 
+// RestoreTeam is the resolver for the restoreTeam field.
+func (r *mutationResolver) RestoreTeam(ctx context.Context, id int) (*model.Team, error) {
+	team, err := r.Repos.Teams.GetTrashed(ctx, id)
+	if err != nil {
+		return nil, failure("team not found in the trash", err)
+	}
+
+	if err := r.Policy.CanManageTeam(ctx, middleware.GetUserFromContext(ctx), team); err != nil {
+		return nil, err
+	}
+
+	if err := r.Repos.Teams.Restore(ctx, team); err != nil {
+		return nil, failure("failed to restore team", err)
+	}
+
+	return teamToModel(team), nil
+}
+
 // AddTicket is the resolver for the addTicket field.
 func (r *mutationResolver) AddTicket(ctx context.Context, input model.TicketInput) (*model.Ticket, error) {
 	status := db.StatusNotStartedDB
@@ -258,91 +424,134 @@ func (r *mutationResolver) AddTicket(ctx context.Context, input model.TicketInpu
 	newTicket := db.Ticket{
 		ProjectID:    input.ProjectID,
 		AssignedToID: input.AssignedToID,
-		Status:       status,
 		Title:        input.Title,
 		Description:  input.Description,
 		Priority:     priority,
 	}
 
-	if err := r.DB.Create(&newTicket).Error; err != nil {
-		return nil, fmt.Errorf("failed to create ticket: %w", err)
+	if err := r.moveStatus(ctx, &newTicket.ProjectID, &newTicket.Status, &newTicket.CompletedAt, status); err != nil {
+		return nil, err
 	}
 
-	return &model.Ticket{
-		ID:           newTicket.ID,
-		ProjectID:    newTicket.ProjectID,
-		AssignedToID: newTicket.AssignedToID,
-		Title:        newTicket.Title,
-		Description:  newTicket.Description,
-		Status:       db.StatusToModel(newTicket.Status),
-		Priority:     model.Priority(newTicket.Priority),
-		CreatedAt:    newTicket.CreatedAt,
-		CompletedAt:  newTicket.CompletedAt,
-	}, nil
+	if err := r.Policy.CanModifyTicket(ctx, middleware.GetUserFromContext(ctx), &newTicket); err != nil {
+		return nil, err
+	}
+
+	if err := r.Repos.Tickets.Create(ctx, &newTicket); err != nil {
+		return nil, failure("failed to create ticket", err)
+	}
+
+	return ticketToModel(&newTicket), nil
 }
 
 // UpdateTicket is the resolver for the updateTicket field.
-func (r *mutationResolver) UpdateTicket(ctx context.Context, id int, input model.TicketInput) (*model.Ticket, error) {
-	var ticket db.Ticket
-	if err := r.DB.Where("id = ?", id).First(&ticket).Error; err != nil {
-		return nil, fmt.Errorf("ticket not found: %w", err)
+func (r *mutationResolver) UpdateTicket(ctx context.Context, id int, input model.TicketPatchInput) (*model.Ticket, error) {
+	ticket, err := r.Repos.Tickets.Get(ctx, id)
+	if err != nil {
+		return nil, failure("ticket not found", err)
 	}
 
-	if input.Title != "" {
-		ticket.Title = input.Title
-	}
-	if input.Description != nil {
-		ticket.Description = input.Description
+	user := middleware.GetUserFromContext(ctx)
+	if err := r.Policy.CanModifyTicket(ctx, user, ticket); err != nil {
+		return nil, err
 	}
-	if input.Status != "" {
-		ticket.Status = db.StatusToDB(input.Status)
+
+	if title, ok := given(input.Title); ok {
+		ticket.Title = title
 	}
-	if input.ProjectID != 0 {
-		ticket.ProjectID = input.ProjectID
+	patch(&ticket.Description, input.Description)
+	if projectID, ok := given(input.ProjectID); ok {
+		ticket.ProjectID = projectID
 	}
-	if input.AssignedToID != nil {
-		ticket.AssignedToID = input.AssignedToID
+	patch(&ticket.AssignedToID, input.AssignedToID)
+	if priority, ok := given(input.Priority); ok {
+		ticket.Priority = string(priority)
 	}
-	if input.Priority != nil {
-		ticket.Priority = string(*input.Priority)
+	// A ticket moved to another project must fit that project's workflow
+	if status, ok := given(input.Status); ok || input.ProjectID.IsSet() {
+		to := ticket.Status
+		if ok {
+			to = db.StatusToDB(status)
+		}
+		if err := r.moveStatus(ctx, &ticket.ProjectID, &ticket.Status, &ticket.CompletedAt, to); err != nil {
+			return nil, err
+		}
 	}
 
-	if input.Status == "COMPLETED" {
-		now := time.Now()
-		ticket.CompletedAt = &now
+	// The caller must still be allowed to work on the ticket after the change
+	if err := r.Policy.CanModifyTicket(ctx, user, ticket); err != nil {
+		return nil, err
 	}
 
-	if err := r.DB.Save(&ticket).Error; err != nil {
-		return nil, fmt.Errorf("failed to update ticket: %w", err)
+	if err := r.Repos.Tickets.Save(ctx, ticket); err != nil {
+		return nil, failure("failed to update ticket", err)
 	}
 
-	return &model.Ticket{
-		ID:           ticket.ID,
-		ProjectID:    ticket.ProjectID,
-		AssignedToID: ticket.AssignedToID,
-		Title:        ticket.Title,
-		Description:  ticket.Description,
-		Status:       db.StatusToModel(ticket.Status),
-		Priority:     model.Priority(ticket.Priority),
-		CreatedAt:    ticket.CreatedAt,
-		CompletedAt:  ticket.CompletedAt,
-	}, nil
+	return ticketToModel(ticket), nil
 }
 
 // DeleteTicket is the resolver for the deleteTicket field.
 func (r *mutationResolver) DeleteTicket(ctx context.Context, id int) (bool, error) {
-	var ticket db.Ticket
-	if err := r.DB.Where("id = ?", id).First(&ticket).Error; err != nil {
-		return false, fmt.Errorf("ticket not found: %w", err)
+	ticket, err := r.Repos.Tickets.Get(ctx, id)
+	if err != nil {
+		return false, failure("ticket not found", err)
+	}
+
+	if err := r.Policy.CanModifyTicket(ctx, middleware.GetUserFromContext(ctx), ticket); err != nil {
+		return false, err
 	}
 
-	if err := r.DB.Delete(&ticket).Error; err != nil {
-		return false, fmt.Errorf("failed to delete ticket: %w", err)
+	if err := r.Repos.Tickets.Delete(ctx, ticket); err != nil {
+		return false, failure("failed to delete ticket", err)
 	}
 
 	return true, nil
 }
 
+// RestoreTicket is the resolver for the restoreTicket field.
+func (r *mutationResolver) RestoreTicket(ctx context.Context, id int) (*model.Ticket, error) {
+	ticket, err := r.Repos.Tickets.GetTrashed(ctx, id)
+	if err != nil {
+		return nil, failure("ticket not found in the trash", err)
+	}
+
+	if err := r.Policy.CanModifyTicket(ctx, middleware.GetUserFromContext(ctx), ticket); err != nil {
+		return nil, err
+	}
+
+	if err := r.checkProjectLive(ctx, &ticket.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if err := r.Repos.Tickets.Restore(ctx, ticket); err != nil {
+		return nil, failure("failed to restore ticket", err)
+	}
+
+	return ticketToModel(ticket), nil
+}
+
+// TransitionTicket is the resolver for the transitionTicket field.
+func (r *mutationResolver) TransitionTicket(ctx context.Context, id int, to model.Status) (*model.Ticket, error) {
+	ticket, err := r.Repos.Tickets.Get(ctx, id)
+	if err != nil {
+		return nil, failure("ticket not found", err)
+	}
+
+	if err := r.Policy.CanModifyTicket(ctx, middleware.GetUserFromContext(ctx), ticket); err != nil {
+		return nil, err
+	}
+
+	if err := r.moveStatus(ctx, &ticket.ProjectID, &ticket.Status, &ticket.CompletedAt, db.StatusToDB(to)); err != nil {
+		return nil, err
+	}
+
+	if err := r.Repos.Tickets.Save(ctx, ticket); err != nil {
+		return nil, failure("failed to update ticket", err)
+	}
+
+	return ticketToModel(ticket), nil
+}
+
 // AddTask is the resolver for the addTask field.
 func (r *mutationResolver) AddTask(ctx context.Context, input model.TaskInput) (*model.Task, error) {
 	status := db.StatusNotStartedDB
@@ -360,105 +569,231 @@ func (r *mutationResolver) AddTask(ctx context.Context, input model.TaskInput) (
 		Description:  input.Description,
 		AssignedToID: input.AssignedToID,
 		ProjectID:    input.ProjectID,
-		Status:       status,
 		Priority:     priority,
 	}
 
+	if err := r.moveStatus(ctx, newTask.ProjectID, &newTask.Status, &newTask.CompletedAt, status); err != nil {
+		return nil, err
+	}
+
 	if input.DueDate != nil {
 		dueDate, err := time.Parse("2006-01-02", *input.DueDate)
 		if err != nil {
-			return nil, fmt.Errorf("invalid due date format: %w", err)
+			return nil, db.InvalidInput("invalid due date format: %v", err)
 		}
 		newTask.DueDate = &dueDate
 	}
 
-	if err := r.DB.Create(&newTask).Error; err != nil {
-		return nil, fmt.Errorf("failed to create task: %w", err)
+	if err := r.Policy.CanModifyTask(ctx, middleware.GetUserFromContext(ctx), &newTask); err != nil {
+		return nil, err
 	}
 
-	result := &model.Task{
-		ID:           newTask.ID,
-		Title:        newTask.Title,
-		Description:  newTask.Description,
-		Status:       db.StatusToModel(newTask.Status),
-		Priority:     model.Priority(newTask.Priority),
-		CreatedAt:    newTask.CreatedAt,
-		AssignedToID: newTask.AssignedToID,
-		ProjectID:    newTask.ProjectID,
-		DueDate:      input.DueDate,
+	if err := r.Repos.Tasks.Create(ctx, &newTask); err != nil {
+		return nil, failure("failed to create task", err)
 	}
 
-	return result, nil
+	return taskToModel(&newTask), nil
 }
 
 // UpdateTask is the resolver for the updateTask field.
-func (r *mutationResolver) UpdateTask(ctx context.Context, id int, input model.TaskInput) (*model.Task, error) {
-	var task db.Task
-	if err := r.DB.Where("id = ?", id).First(&task).Error; err != nil {
-		return nil, fmt.Errorf("task not found: %w", err)
+func (r *mutationResolver) UpdateTask(ctx context.Context, id int, input model.TaskPatchInput) (*model.Task, error) {
+	task, err := r.Repos.Tasks.Get(ctx, id)
+	if err != nil {
+		return nil, failure("task not found", err)
 	}
 
-	if input.Title != "" {
-		task.Title = input.Title
+	user := middleware.GetUserFromContext(ctx)
+	if err := r.Policy.CanModifyTask(ctx, user, task); err != nil {
+		return nil, err
 	}
-	if input.Description != nil {
-		task.Description = input.Description
+
+	if title, ok := given(input.Title); ok {
+		task.Title = title
 	}
-	if input.AssignedToID != nil {
-		task.AssignedToID = input.AssignedToID
+	patch(&task.Description, input.Description)
+	patch(&task.AssignedToID, input.AssignedToID)
+	patch(&task.ProjectID, input.ProjectID)
+	if priority, ok := given(input.Priority); ok {
+		task.Priority = string(priority)
 	}
-	if input.ProjectID != nil {
-		task.ProjectID = input.ProjectID
+	// A task moved to another project must fit that project's workflow
+	if status, ok := given(input.Status); ok || input.ProjectID.IsSet() {
+		to := task.Status
+		if ok {
+			to = db.StatusToDB(status)
+		}
+		if err := r.moveStatus(ctx, task.ProjectID, &task.Status, &task.CompletedAt, to); err != nil {
+			return nil, err
+		}
 	}
-	if input.Status != "" {
-		task.Status = db.StatusToDB(input.Status)
+
+	if dueDate, ok := input.DueDate.ValueOK(); ok {
+		task.DueDate = nil
+		if dueDate != nil {
+			parsed, err := time.Parse("2006-01-02", *dueDate)
+			if err != nil {
+				return nil, db.InvalidInput("invalid due date format: %v", err)
+			}
+			task.DueDate = &parsed
+		}
 	}
-	if input.Priority != nil {
-		task.Priority = string(*input.Priority)
+
+	// The caller must still be allowed to work on the task after the change
+	if err := r.Policy.CanModifyTask(ctx, user, task); err != nil {
+		return nil, err
 	}
 
-	if input.DueDate != nil {
-		dueDate, err := time.Parse("2006-01-02", *input.DueDate)
+	if err := r.Repos.Tasks.Save(ctx, task); err != nil {
+		return nil, failure("failed to update task", err)
+	}
+
+	return taskToModel(task), nil
+}
+
+// DeleteTask is the resolver for the deleteTask field.
+func (r *mutationResolver) DeleteTask(ctx context.Context, id int) (bool, error) {
+	task, err := r.Repos.Tasks.Get(ctx, id)
+	if err != nil {
+		return false, failure("task not found", err)
+	}
+
+	if err := r.Policy.CanModifyTask(ctx, middleware.GetUserFromContext(ctx), task); err != nil {
+		return false, err
+	}
+
+	if err := r.Repos.Tasks.Delete(ctx, task); err != nil {
+		return false, failure("failed to delete task", err)
+	}
+
+	return true, nil
+}
+
+// RestoreTask is the resolver for the restoreTask field.
+func (r *mutationResolver) RestoreTask(ctx context.Context, id int) (*model.Task, error) {
+	task, err := r.Repos.Tasks.GetTrashed(ctx, id)
+	if err != nil {
+		return nil, failure("task not found in the trash", err)
+	}
+
+	if err := r.Policy.CanModifyTask(ctx, middleware.GetUserFromContext(ctx), task); err != nil {
+		return nil, err
+	}
+
+	if err := r.checkProjectLive(ctx, task.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if err := r.Repos.Tasks.Restore(ctx, task); err != nil {
+		return nil, failure("failed to restore task", err)
+	}
+
+	return taskToModel(task), nil
+}
+
+// PurgeTrash is the resolver for the purgeTrash field.
+func (r *mutationResolver) PurgeTrash(ctx context.Context, olderThanDays *int) (*model.PurgeResult, error) {
+	cutoff, err := r.purgeCutoff(olderThanDays, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// Comments and work go first so they are counted before purged work and projects take
+	// theirs along
+	result := &model.PurgeResult{Cutoff: cutoff}
+	purges := []struct {
+		count *int
+		purge func(context.Context, time.Time) (int64, error)
+	}{
+		{&result.Comments, r.Repos.Comments.Purge},
+		{&result.Tickets, r.Repos.Tickets.Purge},
+		{&result.Tasks, r.Repos.Tasks.Purge},
+		{&result.Projects, r.Repos.Projects.Purge},
+		{&result.Teams, r.Repos.Teams.Purge},
+		{&result.Employees, r.Repos.Employees.Purge},
+	}
+	for _, p := range purges {
+		purged, err := p.purge(ctx, cutoff)
 		if err != nil {
-			return nil, fmt.Errorf("invalid due date format: %w", err)
+			return nil, failure("failed to purge trash", err)
+		}
+		*p.count = int(purged)
+	}
+
+	return result, nil
+}
+
+// AddComment is the resolver for the addComment field.
+func (r *mutationResolver) AddComment(ctx context.Context, input model.CommentInput) (*model.Comment, error) {
+	targets := 0
+	for _, id := range []*int{input.TicketID, input.TaskID, input.ParentID} {
+		if id != nil {
+			targets++
 		}
-		task.DueDate = &dueDate
+	}
+	if targets != 1 {
+		return nil, db.InvalidInput("a comment needs exactly one of ticketID, taskID and parentID")
 	}
 
-	if input.Status == "COMPLETED" {
-		now := time.Now()
-		task.CompletedAt = &now
+	user := middleware.GetUserFromContext(ctx)
+	newComment := db.Comment{
+		TicketID: input.TicketID,
+		TaskID:   input.TaskID,
+		ParentID: input.ParentID,
+		AuthorID: &user.ID,
+		Body:     input.Body,
 	}
 
-	if err := r.DB.Save(&task).Error; err != nil {
-		return nil, fmt.Errorf("failed to update task: %w", err)
+	// A reply goes on the ticket or task of the comment it answers
+	if input.ParentID != nil {
+		parent, err := r.visibleComment(ctx, *input.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		newComment.TicketID, newComment.TaskID = parent.TicketID, parent.TaskID
+	} else if err := r.checkCommentTarget(ctx, input.TicketID, input.TaskID); err != nil {
+		return nil, err
 	}
 
-	result := &model.Task{
-		ID:           task.ID,
-		Title:        task.Title,
-		Description:  task.Description,
-		Status:       db.StatusToModel(task.Status),
-		Priority:     model.Priority(task.Priority),
-		CreatedAt:    task.CreatedAt,
-		CompletedAt:  task.CompletedAt,
-		AssignedToID: task.AssignedToID,
-		ProjectID:    task.ProjectID,
-		DueDate:      input.DueDate,
+	if err := r.Repos.Comments.Create(ctx, &newComment); err != nil {
+		return nil, failure("failed to create comment", err)
 	}
 
-	return result, nil
+	return commentToModel(&newComment), nil
 }
 
-// DeleteTask is the resolver for the deleteTask field.
-func (r *mutationResolver) DeleteTask(ctx context.Context, id int) (bool, error) {
-	var task db.Task
-	if err := r.DB.Where("id = ?", id).First(&task).Error; err != nil {
-		return false, fmt.Errorf("task not found: %w", err)
+// EditComment is the resolver for the editComment field.
+func (r *mutationResolver) EditComment(ctx context.Context, id int, body string) (*model.Comment, error) {
+	comment, err := r.visibleComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.Policy.CanEditComment(ctx, middleware.GetUserFromContext(ctx), comment); err != nil {
+		return nil, err
+	}
+
+	if body != comment.Body {
+		if err := r.Repos.Comments.Edit(ctx, comment, body); err != nil {
+			return nil, failure("failed to edit comment", err)
+		}
 	}
 
-	if err := r.DB.Delete(&task).Error; err != nil {
-		return false, fmt.Errorf("failed to delete task: %w", err)
+	return commentToModel(comment), nil
+}
+
+// DeleteComment is the resolver for the deleteComment field.
+func (r *mutationResolver) DeleteComment(ctx context.Context, id int) (bool, error) {
+	comment, err := r.visibleComment(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.Policy.CanDeleteComment(ctx, middleware.GetUserFromContext(ctx), comment); err != nil {
+		return false, err
+	}
+
+	if err := r.Repos.Comments.Delete(ctx, comment); err != nil {
+		return false, failure("failed to delete comment", err)
 	}
 
 	return true, nil
@@ -467,9 +802,8 @@ func (r *mutationResolver) DeleteTask(ctx context.Context, id int) (bool, error)
 // AddNotification is the resolver for the addNotification field.
 func (r *mutationResolver) AddNotification(ctx context.Context, message string, employeeID int, typeArg *model.NotificationType) (*model.Notification, error) {
 	// Verify employee exists
-	var employee db.Employee
-	if err := r.DB.Where("id = ?", employeeID).First(&employee).Error; err != nil {
-		return nil, fmt.Errorf("employee not found: %w", err)
+	if _, err := r.Repos.Employees.Get(ctx, employeeID); err != nil {
+		return nil, failure("employee not found", err)
 	}
 
 	notificationTypeStr := "INFO"
@@ -484,30 +818,27 @@ func (r *mutationResolver) AddNotification(ctx context.Context, message string,
 		Read:       false,
 	}
 
-	if err := r.DB.Create(&newNotification).Error; err != nil {
-		return nil, fmt.Errorf("failed to create notification: %w", err)
+	if err := r.Repos.Notifications.Create(ctx, &newNotification); err != nil {
+		return nil, failure("failed to create notification", err)
 	}
 
-	return &model.Notification{
-		ID:         newNotification.ID,
-		Message:    newNotification.Message,
-		EmployeeID: newNotification.EmployeeID,
-		Type:       model.NotificationType(newNotification.Type),
-		Read:       newNotification.Read,
-		CreatedAt:  newNotification.CreatedAt,
-	}, nil
+	return notificationToModel(&newNotification), nil
 }
 
 // MarkNotificationRead is the resolver for the markNotificationRead field.
 func (r *mutationResolver) MarkNotificationRead(ctx context.Context, id int) (bool, error) {
-	var notification db.Notification
-	if err := r.DB.Where("id = ?", id).First(&notification).Error; err != nil {
-		return false, fmt.Errorf("notification not found: %w", err)
+	notification, err := r.Repos.Notifications.Get(ctx, id)
+	if err != nil {
+		return false, failure("notification not found", err)
+	}
+
+	if err := r.Policy.CanAccessNotifications(ctx, middleware.GetUserFromContext(ctx), notification.EmployeeID); err != nil {
+		return false, err
 	}
 
 	notification.Read = true
-	if err := r.DB.Save(&notification).Error; err != nil {
-		return false, fmt.Errorf("failed to mark notification as read: %w", err)
+	if err := r.Repos.Notifications.Save(ctx, notification); err != nil {
+		return false, failure("failed to mark notification as read", err)
 	}
 
 	return true, nil
@@ -516,14 +847,17 @@ func (r *mutationResolver) MarkNotificationRead(ctx context.Context, id int) (bo
 // AddTeamEngineer is the resolver for the addTeamEngineer field.
 func (r *mutationResolver) AddTeamEngineer(ctx context.Context, input model.TeamEngineerInput) (*model.TeamEngineer, error) {
 	// Verify team and engineer exist
-	var team db.Team
-	if err := r.DB.Where("id = ?", input.TeamID).First(&team).Error; err != nil {
-		return nil, fmt.Errorf("team not found: %w", err)
+	team, err := r.Repos.Teams.Get(ctx, input.TeamID)
+	if err != nil {
+		return nil, failure("team not found", err)
+	}
+
+	if err := r.Policy.CanManageTeamMembers(ctx, middleware.GetUserFromContext(ctx), team); err != nil {
+		return nil, err
 	}
 
-	var employee db.Employee
-	if err := r.DB.Where("id = ?", input.EngineerID).First(&employee).Error; err != nil {
-		return nil, fmt.Errorf("engineer not found: %w", err)
+	if _, err := r.Repos.Employees.Get(ctx, input.EngineerID); err != nil {
+		return nil, failure("engineer not found", err)
 	}
 
 	teamEngineer := db.TeamEngineer{
@@ -531,21 +865,26 @@ func (r *mutationResolver) AddTeamEngineer(ctx context.Context, input model.Team
 		EngineerID: input.EngineerID,
 	}
 
-	if err := r.DB.Create(&teamEngineer).Error; err != nil {
-		return nil, fmt.Errorf("failed to add engineer to team: %w", err)
+	if err := r.Repos.Teams.AddEngineer(ctx, &teamEngineer); err != nil {
+		return nil, failure("failed to add engineer to team", err)
 	}
 
-	return &model.TeamEngineer{
-		TeamID:     teamEngineer.TeamID,
-		EngineerID: teamEngineer.EngineerID,
-		CreatedAt:  teamEngineer.CreatedAt,
-	}, nil
+	return teamEngineerToModel(&teamEngineer), nil
 }
 
 // RemoveTeamEngineer is the resolver for the removeTeamEngineer field.
 func (r *mutationResolver) RemoveTeamEngineer(ctx context.Context, input model.TeamEngineerInput) (bool, error) {
-	if err := r.DB.Where("team_id = ? AND engineer_id = ?", input.TeamID, input.EngineerID).Delete(&db.TeamEngineer{}).Error; err != nil {
-		return false, fmt.Errorf("failed to remove engineer from team: %w", err)
+	team, err := r.Repos.Teams.Get(ctx, input.TeamID)
+	if err != nil {
+		return false, failure("team not found", err)
+	}
+
+	if err := r.Policy.CanManageTeamMembers(ctx, middleware.GetUserFromContext(ctx), team); err != nil {
+		return false, err
+	}
+
+	if err := r.Repos.Teams.RemoveEngineer(ctx, input.TeamID, input.EngineerID); err != nil {
+		return false, failure("failed to remove engineer from team", err)
 	}
 
 	return true, nil
@@ -554,14 +893,17 @@ func (r *mutationResolver) RemoveTeamEngineer(ctx context.Context, input model.T
 // AddProjectTeam is the resolver for the addProjectTeam field.
 func (r *mutationResolver) AddProjectTeam(ctx context.Context, input model.ProjectTeamInput) (*model.ProjectTeam, error) {
 	// Verify project and team exist
-	var project db.Project
-	if err := r.DB.Where("id = ?", input.ProjectID).First(&project).Error; err != nil {
-		return nil, fmt.Errorf("project not found: %w", err)
+	project, err := r.Repos.Projects.Get(ctx, input.ProjectID)
+	if err != nil {
+		return nil, failure("project not found", err)
+	}
+
+	if err := r.Policy.CanManageProject(ctx, middleware.GetUserFromContext(ctx), project); err != nil {
+		return nil, err
 	}
 
-	var team db.Team
-	if err := r.DB.Where("id = ?", input.TeamID).First(&team).Error; err != nil {
-		return nil, fmt.Errorf("team not found: %w", err)
+	if _, err := r.Repos.Teams.Get(ctx, input.TeamID); err != nil {
+		return nil, failure("team not found", err)
 	}
 
 	projectTeam := db.ProjectTeam{
@@ -569,21 +911,26 @@ func (r *mutationResolver) AddProjectTeam(ctx context.Context, input model.Proje
 		TeamID:    input.TeamID,
 	}
 
-	if err := r.DB.Create(&projectTeam).Error; err != nil {
-		return nil, fmt.Errorf("failed to add team to project: %w", err)
+	if err := r.Repos.Projects.AddTeam(ctx, &projectTeam); err != nil {
+		return nil, failure("failed to add team to project", err)
 	}
 
-	return &model.ProjectTeam{
-		ProjectID: projectTeam.ProjectID,
-		TeamID:    projectTeam.TeamID,
-		CreatedAt: projectTeam.CreatedAt,
-	}, nil
+	return projectTeamToModel(&projectTeam), nil
 }
 
 // RemoveProjectTeam is the resolver for the removeProjectTeam field.
 func (r *mutationResolver) RemoveProjectTeam(ctx context.Context, input model.ProjectTeamInput) (bool, error) {
-	if err := r.DB.Where("project_id = ? AND team_id = ?", input.ProjectID, input.TeamID).Delete(&db.ProjectTeam{}).Error; err != nil {
-		return false, fmt.Errorf("failed to remove team from project: %w", err)
+	project, err := r.Repos.Projects.Get(ctx, input.ProjectID)
+	if err != nil {
+		return false, failure("project not found", err)
+	}
+
+	if err := r.Policy.CanManageProject(ctx, middleware.GetUserFromContext(ctx), project); err != nil {
+		return false, err
+	}
+
+	if err := r.Repos.Projects.RemoveTeam(ctx, input.ProjectID, input.TeamID); err != nil {
+		return false, failure("failed to remove team from project", err)
 	}
 
 	return true, nil
@@ -592,14 +939,17 @@ func (r *mutationResolver) RemoveProjectTeam(ctx context.Context, input model.Pr
 // AddProjectEmployee is the resolver for the addProjectEmployee field.
 func (r *mutationResolver) AddProjectEmployee(ctx context.Context, input model.ProjectEmployeeInput) (*model.ProjectEmployee, error) {
 	// Verify project and employee exist
-	var project db.Project
-	if err := r.DB.Where("id = ?", input.ProjectID).First(&project).Error; err != nil {
-		return nil, fmt.Errorf("project not found: %w", err)
+	project, err := r.Repos.Projects.Get(ctx, input.ProjectID)
+	if err != nil {
+		return nil, failure("project not found", err)
+	}
+
+	if err := r.Policy.CanManageProject(ctx, middleware.GetUserFromContext(ctx), project); err != nil {
+		return nil, err
 	}
 
-	var employee db.Employee
-	if err := r.DB.Where("id = ?", input.EmployeeID).First(&employee).Error; err != nil {
-		return nil, fmt.Errorf("employee not found: %w", err)
+	if _, err := r.Repos.Employees.Get(ctx, input.EmployeeID); err != nil {
+		return nil, failure("employee not found", err)
 	}
 
 	projectEmployee := db.ProjectEmployee{
@@ -608,22 +958,26 @@ func (r *mutationResolver) AddProjectEmployee(ctx context.Context, input model.P
 		Role:       input.Role,
 	}
 
-	if err := r.DB.Create(&projectEmployee).Error; err != nil {
-		return nil, fmt.Errorf("failed to add employee to project: %w", err)
+	if err := r.Repos.Projects.AddEmployee(ctx, &projectEmployee); err != nil {
+		return nil, failure("failed to add employee to project", err)
 	}
 
-	return &model.ProjectEmployee{
-		ProjectID:  projectEmployee.ProjectID,
-		EmployeeID: projectEmployee.EmployeeID,
-		Role:       projectEmployee.Role,
-		CreatedAt:  projectEmployee.CreatedAt,
-	}, nil
+	return projectEmployeeToModel(&projectEmployee), nil
 }
 
 // RemoveProjectEmployee is the resolver for the removeProjectEmployee field.
 func (r *mutationResolver) RemoveProjectEmployee(ctx context.Context, input model.ProjectEmployeeInput) (bool, error) {
-	if err := r.DB.Where("project_id = ? AND employee_id = ?", input.ProjectID, input.EmployeeID).Delete(&db.ProjectEmployee{}).Error; err != nil {
-		return false, fmt.Errorf("failed to remove employee from project: %w", err)
+	project, err := r.Repos.Projects.Get(ctx, input.ProjectID)
+	if err != nil {
+		return false, failure("project not found", err)
+	}
+
+	if err := r.Policy.CanManageProject(ctx, middleware.GetUserFromContext(ctx), project); err != nil {
+		return false, err
+	}
+
+	if err := r.Repos.Projects.RemoveEmployee(ctx, input.ProjectID, input.EmployeeID); err != nil {
+		return false, failure("failed to remove employee from project", err)
 	}
 
 	return true, nil
@@ -631,23 +985,27 @@ func (r *mutationResolver) RemoveProjectEmployee(ctx context.Context, input mode
 
 // UpdateProjectEmployeeRole is the resolver for the updateProjectEmployeeRole field.
 func (r *mutationResolver) UpdateProjectEmployeeRole(ctx context.Context, input model.ProjectEmployeeInput) (*model.ProjectEmployee, error) {
-	var projectEmployee db.ProjectEmployee
-	if err := r.DB.Where("project_id = ? AND employee_id = ?", input.ProjectID, input.EmployeeID).First(&projectEmployee).Error; err != nil {
-		return nil, fmt.Errorf("project employee relationship not found: %w", err)
+	project, err := r.Repos.Projects.Get(ctx, input.ProjectID)
+	if err != nil {
+		return nil, failure("project not found", err)
+	}
+
+	if err := r.Policy.CanManageProject(ctx, middleware.GetUserFromContext(ctx), project); err != nil {
+		return nil, err
+	}
+
+	projectEmployee, err := r.Repos.Projects.GetEmployee(ctx, input.ProjectID, input.EmployeeID)
+	if err != nil {
+		return nil, failure("project employee relationship not found", err)
 	}
 
 	projectEmployee.Role = input.Role
 
-	if err := r.DB.Save(&projectEmployee).Error; err != nil {
-		return nil, fmt.Errorf("failed to update project employee role: %w", err)
+	if err := r.Repos.Projects.SaveEmployee(ctx, projectEmployee); err != nil {
+		return nil, failure("failed to update project employee role", err)
 	}
 
-	return &model.ProjectEmployee{
-		ProjectID:  projectEmployee.ProjectID,
-		EmployeeID: projectEmployee.EmployeeID,
-		Role:       projectEmployee.Role,
-		CreatedAt:  projectEmployee.CreatedAt,
-	}, nil
+	return projectEmployeeToModel(projectEmployee), nil
 }
 
 // Mutation returns generated.MutationResolver implementation.
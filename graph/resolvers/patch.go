@@ -0,0 +1,22 @@
+package resolvers
+
+import "github.com/99designs/gqlgen/graphql"
+
+// patch applies an optional field of a patch input: omitted leaves *dst as it is and an
+// explicit null clears it
+func patch[T any](dst **T, field graphql.Omittable[*T]) {
+	if value, ok := field.ValueOK(); ok {
+		*dst = value
+	}
+}
+
+// given returns the value of a patch field that cannot be cleared, and whether it was
+// sent. @constraint(notNull) has already rejected an explicit null.
+func given[T any](field graphql.Omittable[*T]) (T, bool) {
+	value, ok := field.ValueOK()
+	if !ok || value == nil {
+		var zero T
+		return zero, false
+	}
+	return *value, true
+}
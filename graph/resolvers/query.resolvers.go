@@ -6,431 +6,385 @@ package resolvers
 
 import (
 	"context"
-	"fmt"
+	"errors"
 
 	"github.com/JonJenson-MFIn/project-management-system-api/db"
 	"github.com/JonJenson-MFIn/project-management-system-api/graph/generated"
 	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
-	"golang.org/x/crypto/bcrypt"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"github.com/JonJenson-MFIn/project-management-system-api/repository"
 )
 
 // Employees is the resolver for the employees field.
-func (r *queryResolver) Employees(ctx context.Context, filter *model.EmployeeFilter) ([]*model.Employee, error) {
-	query := db.DB.Model(&db.Employee{})
-
-	if filter != nil {
-		if filter.Name != nil && *filter.Name != "" {
-			query = query.Where("name LIKE ?", "%"+*filter.Name+"%")
-		}
-
-		if filter.Email != nil && *filter.Email != "" {
-			query = query.Where("email LIKE ?", "%"+*filter.Email+"%")
-		}
-
-		if filter.Role != nil {
-			query = query.Where("role = ?", db.RoleToDB(*filter.Role))
-		}
-
-		if filter.Active != nil {
-			query = query.Where("active = ?", *filter.Active)
-		}
-
-		if filter.ProjectAssignedID != nil {
-			query = query.Where("project_assigned_id = ?", *filter.ProjectAssignedID)
-		}
-
-		if filter.CreatedAtAfter != nil {
-			query = query.Where("created_at >= ?", *filter.CreatedAtAfter)
-		}
-
-		if filter.CreatedAtBefore != nil {
-			query = query.Where("created_at <= ?", *filter.CreatedAtBefore)
-		}
-	}
-
-	var employees []db.Employee
-	if err := query.Find(&employees).Error; err != nil {
-		return nil, fmt.Errorf("failed to fetch employees: %w", err)
+func (r *queryResolver) Employees(ctx context.Context, filter *model.EmployeeFilter, sort []*model.SortInput, first *int, after *string, last *int, before *string) (*model.EmployeeConnection, error) {
+	page, err := r.Repos.Employees.List(ctx, filter, db.PageArgs{First: first, After: after, Last: last, Before: before, Sort: sort})
+	if err != nil {
+		return nil, failure("failed to fetch employees", err)
 	}
 
-	result := make([]*model.Employee, len(employees))
-	for i, emp := range employees {
-		result[i] = &model.Employee{
-			ID:                emp.ID,
-			Name:              emp.Name,
-			Email:             emp.Email,
-			Role:              db.RoleToModel(emp.Role),
-			Active:            emp.Active,
-			ProjectAssignedID: emp.ProjectAssignedID,
-			CreatedAt:         emp.CreatedAt,
-			UpdatedAt:         emp.UpdatedAt,
+	edges := make([]*model.EmployeeEdge, len(page.Rows))
+	for i := range page.Rows {
+		edges[i] = &model.EmployeeEdge{
+			Cursor: page.Cursors[i],
+			Node:   employeeToModel(&page.Rows[i]),
 		}
 	}
-	return result, nil
+	return &model.EmployeeConnection{Edges: edges, PageInfo: page.PageInfo, TotalCount: page.TotalCount}, nil
 }
 
 // Employee is the resolver for the employee field.
 func (r *queryResolver) Employee(ctx context.Context, id int) (*model.Employee, error) {
-	var employee db.Employee
-	if err := db.DB.First(&employee, id).Error; err != nil {
-		return nil, fmt.Errorf("employee not found: %w", err)
-	}
-
-	return &model.Employee{
-		ID:                employee.ID,
-		Name:              employee.Name,
-		Email:             employee.Email,
-		Role:              db.RoleToModel(employee.Role),
-		Active:            employee.Active,
-		ProjectAssignedID: employee.ProjectAssignedID,
-		CreatedAt:         employee.CreatedAt,
-		UpdatedAt:         employee.UpdatedAt,
-	}, nil
+	employee, err := r.Repos.Employees.Get(ctx, id)
+	if err != nil {
+		return nil, failure("employee not found", err)
+	}
+
+	return employeeToModel(employee), nil
 }
 
 // SignIn is the resolver for the signIn field.
-func (r *queryResolver) SignIn(ctx context.Context, email string, password string) (bool, error) {
-	var employee db.Employee
-	if err := db.DB.Where("email = ?", email).First(&employee).Error; err != nil {
-		return false, fmt.Errorf("invalid credentials")
+func (r *queryResolver) SignIn(ctx context.Context, email string, password string) (*model.AuthPayload, error) {
+	employee, err := r.Repos.Employees.GetByEmail(ctx, email)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, signInRejected(ctx, "unknown email")
+	}
+	if err != nil {
+		return nil, failure("failed to sign in", err)
 	}
 
+	// The password is checked before the account state, so a wrong password never reveals it
+	if err := r.Passwords.Compare(employee.Password, password); err != nil {
+		return nil, signInRejected(ctx, "wrong password", "employee_id", employee.ID)
+	}
 	if !employee.Active {
-		return false, fmt.Errorf("account is deactivated")
+		return nil, signInRejected(ctx, "account deactivated", "employee_id", employee.ID)
 	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(employee.Password), []byte(password)); err != nil {
-		return false, fmt.Errorf("invalid credentials")
+	tokens, err := r.Sessions.Create(ctx, employee)
+	if err != nil {
+		return nil, failure("failed to start session", err)
 	}
 
-	return true, nil
+	return &model.AuthPayload{
+		AccessToken:           tokens.AccessToken,
+		ExpiresAt:             tokens.AccessTokenExpiresAt,
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: tokens.RefreshTokenExpiresAt,
+		Employee:              employeeToModel(employee),
+	}, nil
 }
 
 // Projects is the resolver for the projects field.
-func (r *queryResolver) Projects(ctx context.Context, filter *model.ProjectFilter) ([]*model.Project, error) {
-	var projects []db.Project
-	if err := db.DB.Find(&projects).Error; err != nil {
-		return nil, fmt.Errorf("failed to fetch projects: %w", err)
-	}
-
-	result := make([]*model.Project, len(projects))
-	for i, proj := range projects {
-		result[i] = &model.Project{
-			ID:          proj.ID,
-			ManagerID:   proj.ManagerID,
-			Name:        proj.Name,
-			Status:      db.StatusToModel(proj.Status),
-			Description: proj.Description,
-			StartDate:   proj.StartDate,
-			CreatedAt:   proj.CreatedAt,
-			UpdatedAt:   proj.UpdatedAt,
+func (r *queryResolver) Projects(ctx context.Context, filter *model.ProjectFilter, sort []*model.SortInput, first *int, after *string, last *int, before *string) (*model.ProjectConnection, error) {
+	page, err := r.Repos.Projects.List(ctx, filter, db.PageArgs{First: first, After: after, Last: last, Before: before, Sort: sort})
+	if err != nil {
+		return nil, failure("failed to fetch projects", err)
+	}
+
+	edges := make([]*model.ProjectEdge, len(page.Rows))
+	for i := range page.Rows {
+		edges[i] = &model.ProjectEdge{
+			Cursor: page.Cursors[i],
+			Node:   projectToModel(&page.Rows[i]),
 		}
 	}
-	return result, nil
+	return &model.ProjectConnection{Edges: edges, PageInfo: page.PageInfo, TotalCount: page.TotalCount}, nil
 }
 
 // Project is the resolver for the project field.
 func (r *queryResolver) Project(ctx context.Context, id int) (*model.Project, error) {
-	var project db.Project
-	if err := db.DB.First(&project, id).Error; err != nil {
-		return nil, fmt.Errorf("project not found: %w", err)
-	}
-
-	return &model.Project{
-		ID:          project.ID,
-		ManagerID:   project.ManagerID,
-		Name:        project.Name,
-		Status:      db.StatusToModel(project.Status),
-		Description: project.Description,
-		StartDate:   project.StartDate,
-		CreatedAt:   project.CreatedAt,
-		UpdatedAt:   project.UpdatedAt,
-	}, nil
+	project, err := r.Repos.Projects.Get(ctx, id)
+	if err != nil {
+		return nil, failure("project not found", err)
+	}
+
+	return projectToModel(project), nil
 }
 
 // Tasks is the resolver for the tasks field.
-func (r *queryResolver) Tasks(ctx context.Context, filter *model.TaskFilter) ([]*model.Task, error) {
-	var tasks []db.Task
-	if err := db.DB.Find(&tasks).Error; err != nil {
-		return nil, fmt.Errorf("failed to fetch tasks: %w", err)
-	}
-
-	result := make([]*model.Task, len(tasks))
-	for i, task := range tasks {
-		var dueDate *string
-		if task.DueDate != nil {
-			formatted := task.DueDate.Format("2006-01-02T15:04:05Z07:00")
-			dueDate = &formatted
-		}
+func (r *queryResolver) Tasks(ctx context.Context, filter *model.TaskFilter, sort []*model.SortInput, first *int, after *string, last *int, before *string) (*model.TaskConnection, error) {
+	page, err := r.Repos.Tasks.List(ctx, middleware.GetUserFromContext(ctx), filter, db.PageArgs{First: first, After: after, Last: last, Before: before, Sort: sort})
+	if err != nil {
+		return nil, failure("failed to fetch tasks", err)
+	}
 
-		result[i] = &model.Task{
-			ID:           task.ID,
-			Title:        task.Title,
-			Description:  task.Description,
-			AssignedToID: task.AssignedToID,
-			ProjectID:    task.ProjectID,
-			DueDate:      dueDate,
-			Status:       db.StatusToModel(task.Status),
-			Priority:     model.Priority(task.Priority),
-			CreatedAt:    task.CreatedAt,
-			CompletedAt:  task.CompletedAt,
+	edges := make([]*model.TaskEdge, len(page.Rows))
+	for i := range page.Rows {
+		edges[i] = &model.TaskEdge{
+			Cursor: page.Cursors[i],
+			Node:   taskToModel(&page.Rows[i]),
 		}
 	}
-	return result, nil
+	return &model.TaskConnection{Edges: edges, PageInfo: page.PageInfo, TotalCount: page.TotalCount}, nil
 }
 
 // Task is the resolver for the task field.
 func (r *queryResolver) Task(ctx context.Context, id int) (*model.Task, error) {
-	var task db.Task
-	if err := db.DB.First(&task, id).Error; err != nil {
-		return nil, fmt.Errorf("task not found: %w", err)
-	}
-
-	var dueDate *string
-	if task.DueDate != nil {
-		formatted := task.DueDate.Format("2006-01-02T15:04:05Z07:00")
-		dueDate = &formatted
-	}
-
-	return &model.Task{
-		ID:           task.ID,
-		Title:        task.Title,
-		Description:  task.Description,
-		AssignedToID: task.AssignedToID,
-		ProjectID:    task.ProjectID,
-		DueDate:      dueDate,
-		Status:       db.StatusToModel(task.Status),
-		Priority:     model.Priority(task.Priority),
-		CreatedAt:    task.CreatedAt,
-		CompletedAt:  task.CompletedAt,
-	}, nil
+	task, err := r.Repos.Tasks.GetVisible(ctx, middleware.GetUserFromContext(ctx), id)
+	if err != nil {
+		return nil, failure("task not found", err)
+	}
+
+	return taskToModel(task), nil
 }
 
 // Tickets is the resolver for the tickets field.
-func (r *queryResolver) Tickets(ctx context.Context, filter *model.TicketFilter) ([]*model.Ticket, error) {
-	var tickets []db.Ticket
-	if err := db.DB.Find(&tickets).Error; err != nil {
-		return nil, fmt.Errorf("failed to fetch tickets: %w", err)
-	}
-
-	result := make([]*model.Ticket, len(tickets))
-	for i, ticket := range tickets {
-		result[i] = &model.Ticket{
-			ID:           ticket.ID,
-			ProjectID:    ticket.ProjectID,
-			AssignedToID: ticket.AssignedToID,
-			Status:       db.StatusToModel(ticket.Status),
-			Title:        ticket.Title,
-			Description:  ticket.Description,
-			Priority:     model.Priority(ticket.Priority),
-			CreatedAt:    ticket.CreatedAt,
-			CompletedAt:  ticket.CompletedAt,
+func (r *queryResolver) Tickets(ctx context.Context, filter *model.TicketFilter, sort []*model.SortInput, first *int, after *string, last *int, before *string) (*model.TicketConnection, error) {
+	page, err := r.Repos.Tickets.List(ctx, middleware.GetUserFromContext(ctx), filter, db.PageArgs{First: first, After: after, Last: last, Before: before, Sort: sort})
+	if err != nil {
+		return nil, failure("failed to fetch tickets", err)
+	}
+
+	edges := make([]*model.TicketEdge, len(page.Rows))
+	for i := range page.Rows {
+		edges[i] = &model.TicketEdge{
+			Cursor: page.Cursors[i],
+			Node:   ticketToModel(&page.Rows[i]),
 		}
 	}
-	return result, nil
+	return &model.TicketConnection{Edges: edges, PageInfo: page.PageInfo, TotalCount: page.TotalCount}, nil
 }
 
 // Ticket is the resolver for the ticket field.
 func (r *queryResolver) Ticket(ctx context.Context, id int) (*model.Ticket, error) {
-	var ticket db.Ticket
-	if err := db.DB.First(&ticket, id).Error; err != nil {
-		return nil, fmt.Errorf("ticket not found: %w", err)
-	}
-
-	return &model.Ticket{
-		ID:           ticket.ID,
-		ProjectID:    ticket.ProjectID,
-		AssignedToID: ticket.AssignedToID,
-		Status:       db.StatusToModel(ticket.Status),
-		Title:        ticket.Title,
-		Description:  ticket.Description,
-		Priority:     model.Priority(ticket.Priority),
-		CreatedAt:    ticket.CreatedAt,
-		CompletedAt:  ticket.CompletedAt,
-	}, nil
+	ticket, err := r.Repos.Tickets.GetVisible(ctx, middleware.GetUserFromContext(ctx), id)
+	if err != nil {
+		return nil, failure("ticket not found", err)
+	}
+
+	return ticketToModel(ticket), nil
+}
+
+// Workflow is the resolver for the workflow field.
+func (r *queryResolver) Workflow(ctx context.Context, projectID *int) (*model.Workflow, error) {
+	if projectID != nil {
+		if _, err := r.Repos.Projects.Get(ctx, *projectID); err != nil {
+			return nil, failure("project not found", err)
+		}
+	}
+
+	w, err := r.workflowOf(ctx, projectID)
+	if err != nil {
+		return nil, failure("failed to fetch workflow", err)
+	}
+
+	return workflowToModel(w, projectID), nil
 }
 
 // Teams is the resolver for the teams field.
-func (r *queryResolver) Teams(ctx context.Context, filter *model.TeamFilter) ([]*model.Team, error) {
-	var teams []db.Team
-	if err := db.DB.Find(&teams).Error; err != nil {
-		return nil, fmt.Errorf("failed to fetch teams: %w", err)
+func (r *queryResolver) Teams(ctx context.Context, filter *model.TeamFilter, sort []*model.SortInput, first *int, after *string, last *int, before *string) (*model.TeamConnection, error) {
+	page, err := r.Repos.Teams.List(ctx, filter, db.PageArgs{First: first, After: after, Last: last, Before: before, Sort: sort})
+	if err != nil {
+		return nil, failure("failed to fetch teams", err)
 	}
 
-	result := make([]*model.Team, len(teams))
-	for i, team := range teams {
-		result[i] = &model.Team{
-			ID:           team.ID,
-			TeamLeaderID: team.TeamLeaderID,
-			Name:         team.Name,
-			Description:  team.Description,
-			CreatedAt:    team.CreatedAt,
-			UpdatedAt:    team.UpdatedAt,
+	edges := make([]*model.TeamEdge, len(page.Rows))
+	for i := range page.Rows {
+		edges[i] = &model.TeamEdge{
+			Cursor: page.Cursors[i],
+			Node:   teamToModel(&page.Rows[i]),
 		}
 	}
-	return result, nil
+	return &model.TeamConnection{Edges: edges, PageInfo: page.PageInfo, TotalCount: page.TotalCount}, nil
 }
 
 // Team is the resolver for the team field.
 func (r *queryResolver) Team(ctx context.Context, id int) (*model.Team, error) {
-	var team db.Team
-	if err := db.DB.First(&team, id).Error; err != nil {
-		return nil, fmt.Errorf("team not found: %w", err)
-	}
-
-	return &model.Team{
-		ID:           team.ID,
-		TeamLeaderID: team.TeamLeaderID,
-		Name:         team.Name,
-		Description:  team.Description,
-		CreatedAt:    team.CreatedAt,
-		UpdatedAt:    team.UpdatedAt,
-	}, nil
+	team, err := r.Repos.Teams.Get(ctx, id)
+	if err != nil {
+		return nil, failure("team not found", err)
+	}
+
+	return teamToModel(team), nil
+}
+
+// TrashedEmployees is the resolver for the trashedEmployees field.
+func (r *queryResolver) TrashedEmployees(ctx context.Context, sort []*model.SortInput, first *int, after *string, last *int, before *string) (*model.EmployeeConnection, error) {
+	page, err := r.Repos.Employees.ListTrashed(ctx, db.PageArgs{First: first, After: after, Last: last, Before: before, Sort: sort})
+	if err != nil {
+		return nil, failure("failed to fetch trashed employees", err)
+	}
+
+	edges := make([]*model.EmployeeEdge, len(page.Rows))
+	for i := range page.Rows {
+		edges[i] = &model.EmployeeEdge{Cursor: page.Cursors[i], Node: employeeToModel(&page.Rows[i])}
+	}
+	return &model.EmployeeConnection{Edges: edges, PageInfo: page.PageInfo, TotalCount: page.TotalCount}, nil
+}
+
+// TrashedProjects is the resolver for the trashedProjects field.
+func (r *queryResolver) TrashedProjects(ctx context.Context, sort []*model.SortInput, first *int, after *string, last *int, before *string) (*model.ProjectConnection, error) {
+	page, err := r.Repos.Projects.ListTrashed(ctx, db.PageArgs{First: first, After: after, Last: last, Before: before, Sort: sort})
+	if err != nil {
+		return nil, failure("failed to fetch trashed projects", err)
+	}
+
+	edges := make([]*model.ProjectEdge, len(page.Rows))
+	for i := range page.Rows {
+		edges[i] = &model.ProjectEdge{Cursor: page.Cursors[i], Node: projectToModel(&page.Rows[i])}
+	}
+	return &model.ProjectConnection{Edges: edges, PageInfo: page.PageInfo, TotalCount: page.TotalCount}, nil
+}
+
+// TrashedTeams is the resolver for the trashedTeams field.
+func (r *queryResolver) TrashedTeams(ctx context.Context, sort []*model.SortInput, first *int, after *string, last *int, before *string) (*model.TeamConnection, error) {
+	page, err := r.Repos.Teams.ListTrashed(ctx, db.PageArgs{First: first, After: after, Last: last, Before: before, Sort: sort})
+	if err != nil {
+		return nil, failure("failed to fetch trashed teams", err)
+	}
+
+	edges := make([]*model.TeamEdge, len(page.Rows))
+	for i := range page.Rows {
+		edges[i] = &model.TeamEdge{Cursor: page.Cursors[i], Node: teamToModel(&page.Rows[i])}
+	}
+	return &model.TeamConnection{Edges: edges, PageInfo: page.PageInfo, TotalCount: page.TotalCount}, nil
+}
+
+// TrashedTickets is the resolver for the trashedTickets field.
+func (r *queryResolver) TrashedTickets(ctx context.Context, sort []*model.SortInput, first *int, after *string, last *int, before *string) (*model.TicketConnection, error) {
+	page, err := r.Repos.Tickets.ListTrashed(ctx, middleware.GetUserFromContext(ctx), db.PageArgs{First: first, After: after, Last: last, Before: before, Sort: sort})
+	if err != nil {
+		return nil, failure("failed to fetch trashed tickets", err)
+	}
+
+	edges := make([]*model.TicketEdge, len(page.Rows))
+	for i := range page.Rows {
+		edges[i] = &model.TicketEdge{Cursor: page.Cursors[i], Node: ticketToModel(&page.Rows[i])}
+	}
+	return &model.TicketConnection{Edges: edges, PageInfo: page.PageInfo, TotalCount: page.TotalCount}, nil
+}
+
+// TrashedTasks is the resolver for the trashedTasks field.
+func (r *queryResolver) TrashedTasks(ctx context.Context, sort []*model.SortInput, first *int, after *string, last *int, before *string) (*model.TaskConnection, error) {
+	page, err := r.Repos.Tasks.ListTrashed(ctx, middleware.GetUserFromContext(ctx), db.PageArgs{First: first, After: after, Last: last, Before: before, Sort: sort})
+	if err != nil {
+		return nil, failure("failed to fetch trashed tasks", err)
+	}
+
+	edges := make([]*model.TaskEdge, len(page.Rows))
+	for i := range page.Rows {
+		edges[i] = &model.TaskEdge{Cursor: page.Cursors[i], Node: taskToModel(&page.Rows[i])}
+	}
+	return &model.TaskConnection{Edges: edges, PageInfo: page.PageInfo, TotalCount: page.TotalCount}, nil
+}
+
+// AuditLog is the resolver for the auditLog field.
+func (r *queryResolver) AuditLog(ctx context.Context, filter *model.AuditLogFilter, sort []*model.SortInput, first *int, after *string, last *int, before *string) (*model.AuditEntryConnection, error) {
+	page, err := r.Repos.Audit.List(ctx, filter, db.PageArgs{First: first, After: after, Last: last, Before: before, Sort: sort})
+	if err != nil {
+		return nil, failure("failed to fetch audit log", err)
+	}
+
+	edges := make([]*model.AuditEntryEdge, len(page.Rows))
+	for i := range page.Rows {
+		edges[i] = &model.AuditEntryEdge{Cursor: page.Cursors[i], Node: auditEntryToModel(&page.Rows[i])}
+	}
+	return &model.AuditEntryConnection{Edges: edges, PageInfo: page.PageInfo, TotalCount: page.TotalCount}, nil
 }
 
 // Notifications is the resolver for the notifications field.
-func (r *queryResolver) Notifications(ctx context.Context, employeeID int, filter *model.NotificationFilter) ([]*model.Notification, error) {
-	var notifications []db.Notification
-	if err := db.DB.Where("employee_id = ?", employeeID).Find(&notifications).Error; err != nil {
-		return nil, fmt.Errorf("failed to fetch notifications: %w", err)
-	}
-
-	result := make([]*model.Notification, len(notifications))
-	for i, notif := range notifications {
-		result[i] = &model.Notification{
-			ID:         notif.ID,
-			Message:    notif.Message,
-			EmployeeID: notif.EmployeeID,
-			Type:       model.NotificationType(notif.Type),
-			CreatedAt:  notif.CreatedAt,
-			Read:       notif.Read,
+func (r *queryResolver) Notifications(ctx context.Context, employeeID int, filter *model.NotificationFilter, sort []*model.SortInput, first *int, after *string, last *int, before *string) (*model.NotificationConnection, error) {
+	if err := r.Policy.CanAccessNotifications(ctx, middleware.GetUserFromContext(ctx), employeeID); err != nil {
+		return nil, err
+	}
+
+	page, err := r.Repos.Notifications.List(ctx, employeeID, filter, db.PageArgs{First: first, After: after, Last: last, Before: before, Sort: sort})
+	if err != nil {
+		return nil, failure("failed to fetch notifications", err)
+	}
+
+	edges := make([]*model.NotificationEdge, len(page.Rows))
+	for i := range page.Rows {
+		edges[i] = &model.NotificationEdge{
+			Cursor: page.Cursors[i],
+			Node:   notificationToModel(&page.Rows[i]),
 		}
 	}
-	return result, nil
+	return &model.NotificationConnection{Edges: edges, PageInfo: page.PageInfo, TotalCount: page.TotalCount}, nil
 }
 
 // TeamEngineers is the resolver for the teamEngineers field.
 func (r *queryResolver) TeamEngineers(ctx context.Context, teamID int) ([]*model.TeamEngineer, error) {
-	var teamEngineers []db.TeamEngineer
-	if err := db.DB.Where("team_id = ?", teamID).Find(&teamEngineers).Error; err != nil {
-		return nil, fmt.Errorf("failed to fetch team engineers: %w", err)
+	teamEngineers, err := r.Repos.Teams.ListEngineers(ctx, teamID)
+	if err != nil {
+		return nil, failure("failed to fetch team engineers", err)
 	}
 
 	result := make([]*model.TeamEngineer, len(teamEngineers))
-	for i, te := range teamEngineers {
-		result[i] = &model.TeamEngineer{
-			TeamID:     te.TeamID,
-			EngineerID: te.EngineerID,
-			CreatedAt:  te.CreatedAt,
-		}
+	for i := range teamEngineers {
+		result[i] = teamEngineerToModel(&teamEngineers[i])
 	}
 	return result, nil
 }
 
 // ProjectTeams is the resolver for the projectTeams field.
 func (r *queryResolver) ProjectTeams(ctx context.Context, projectID int) ([]*model.ProjectTeam, error) {
-	var projectTeams []db.ProjectTeam
-	if err := db.DB.Where("project_id = ?", projectID).Find(&projectTeams).Error; err != nil {
-		return nil, fmt.Errorf("failed to fetch project teams: %w", err)
+	projectTeams, err := r.Repos.Projects.ListTeams(ctx, projectID)
+	if err != nil {
+		return nil, failure("failed to fetch project teams", err)
 	}
 
 	result := make([]*model.ProjectTeam, len(projectTeams))
-	for i, pt := range projectTeams {
-		result[i] = &model.ProjectTeam{
-			ProjectID: pt.ProjectID,
-			TeamID:    pt.TeamID,
-			CreatedAt: pt.CreatedAt,
-		}
+	for i := range projectTeams {
+		result[i] = projectTeamToModel(&projectTeams[i])
 	}
 	return result, nil
 }
 
 // ProjectEmployees is the resolver for the projectEmployees field.
 func (r *queryResolver) ProjectEmployees(ctx context.Context, projectID int) ([]*model.ProjectEmployee, error) {
-	var projectEmployees []db.ProjectEmployee
-	if err := db.DB.Where("project_id = ?", projectID).Find(&projectEmployees).Error; err != nil {
-		return nil, fmt.Errorf("failed to fetch project employees: %w", err)
+	projectEmployees, err := r.Repos.Projects.ListEmployees(ctx, projectID)
+	if err != nil {
+		return nil, failure("failed to fetch project employees", err)
 	}
 
 	result := make([]*model.ProjectEmployee, len(projectEmployees))
-	for i, pe := range projectEmployees {
-		result[i] = &model.ProjectEmployee{
-			ProjectID:  pe.ProjectID,
-			EmployeeID: pe.EmployeeID,
-			Role:       pe.Role,
-			CreatedAt:  pe.CreatedAt,
-		}
+	for i := range projectEmployees {
+		result[i] = projectEmployeeToModel(&projectEmployees[i])
 	}
 	return result, nil
 }
 
 // EmployeesByProject is the resolver for the employeesByProject field.
 func (r *queryResolver) EmployeesByProject(ctx context.Context, projectID int) ([]*model.Employee, error) {
-	var employees []db.Employee
-	if err := db.DB.Where("project_assigned_id = ?", projectID).Find(&employees).Error; err != nil {
-		return nil, fmt.Errorf("failed to fetch employees by project: %w", err)
+	employees, err := r.Repos.Employees.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, failure("failed to fetch employees by project", err)
 	}
 
 	result := make([]*model.Employee, len(employees))
-	for i, emp := range employees {
-		result[i] = &model.Employee{
-			ID:                emp.ID,
-			Name:              emp.Name,
-			Email:             emp.Email,
-			Role:              db.RoleToModel(emp.Role),
-			Active:            emp.Active,
-			ProjectAssignedID: emp.ProjectAssignedID,
-			CreatedAt:         emp.CreatedAt,
-			UpdatedAt:         emp.UpdatedAt,
-		}
+	for i := range employees {
+		result[i] = employeeToModel(&employees[i])
 	}
 	return result, nil
 }
 
 // TeamsByProject is the resolver for the teamsByProject field.
 func (r *queryResolver) TeamsByProject(ctx context.Context, projectID int) ([]*model.Team, error) {
-	var teams []db.Team
-	if err := db.DB.Joins("JOIN project_teams ON teams.id = project_teams.team_id").
-		Where("project_teams.project_id = ?", projectID).
-		Find(&teams).Error; err != nil {
-		return nil, fmt.Errorf("failed to fetch teams by project: %w", err)
+	byProject, err := r.Repos.Teams.ListByProjects(ctx, []int{projectID})
+	if err != nil {
+		return nil, failure("failed to fetch teams by project", err)
 	}
+	teams := byProject[projectID]
 
 	result := make([]*model.Team, len(teams))
-	for i, team := range teams {
-		result[i] = &model.Team{
-			ID:           team.ID,
-			TeamLeaderID: team.TeamLeaderID,
-			Name:         team.Name,
-			Description:  team.Description,
-			CreatedAt:    team.CreatedAt,
-			UpdatedAt:    team.UpdatedAt,
-		}
+	for i := range teams {
+		result[i] = teamToModel(&teams[i])
 	}
 	return result, nil
 }
 
 // EngineersByTeam is the resolver for the engineersByTeam field.
 func (r *queryResolver) EngineersByTeam(ctx context.Context, teamID int) ([]*model.Employee, error) {
-	var employees []db.Employee
-	if err := db.DB.Joins("JOIN team_engineers ON employees.id = team_engineers.engineer_id").
-		Where("team_engineers.team_id = ?", teamID).
-		Find(&employees).Error; err != nil {
-		return nil, fmt.Errorf("failed to fetch engineers by team: %w", err)
+	byTeam, err := r.Repos.Employees.ListByTeams(ctx, []int{teamID})
+	if err != nil {
+		return nil, failure("failed to fetch engineers by team", err)
 	}
+	employees := byTeam[teamID]
 
 	result := make([]*model.Employee, len(employees))
-	for i, emp := range employees {
-		result[i] = &model.Employee{
-			ID:                emp.ID,
-			Name:              emp.Name,
-			Email:             emp.Email,
-			Role:              db.RoleToModel(emp.Role),
-			Active:            emp.Active,
-			ProjectAssignedID: emp.ProjectAssignedID,
-			CreatedAt:         emp.CreatedAt,
-			UpdatedAt:         emp.UpdatedAt,
-		}
+	for i := range employees {
+		result[i] = employeeToModel(&employees[i])
 	}
 	return result, nil
 }
@@ -1,7 +1,29 @@
 package resolvers
 //go:generate go run github.com/99designs/gqlgen generate
-import "gorm.io/gorm"
+import (
+	"context"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/auth"
+	"github.com/JonJenson-MFIn/project-management-system-api/loaders"
+	"github.com/JonJenson-MFIn/project-management-system-api/policy"
+	"github.com/JonJenson-MFIn/project-management-system-api/repository"
+)
 
 type Resolver struct {
-	DB *gorm.DB
+	Repos     *repository.Repositories
+	Sessions  *auth.SessionManager
+	Passwords *auth.PasswordHasher
+	Policy    *policy.Engine
+	// purgeTrash never removes rows deleted more recently than this
+	TrashRetention time.Duration
+}
+
+// loaders returns the request's DataLoaders, falling back to an unshared set when the
+// schema is served without loaders.Middleware
+func (r *Resolver) loaders(ctx context.Context) *loaders.Loaders {
+	if l := loaders.For(ctx); l != nil {
+		return l
+	}
+	return loaders.New(r.Repos)
 }
@@ -0,0 +1,34 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+)
+
+// checkProjectLive refuses to restore work into a project that is still in the trash,
+// where it would stay out of sight
+func (r *Resolver) checkProjectLive(ctx context.Context, projectID *int) error {
+	if projectID == nil {
+		return nil
+	}
+	if _, err := r.Repos.Projects.GetTrashed(ctx, *projectID); err == nil {
+		return db.InvalidInput("project %d is in the trash; restore it first", *projectID)
+	}
+	return nil
+}
+
+// purgeCutoff is the deletion time before which purgeTrash removes rows. Callers may keep
+// rows longer than the retention, never shorter.
+func (r *Resolver) purgeCutoff(olderThanDays *int, now time.Time) (time.Time, error) {
+	olderThan := r.TrashRetention
+	if olderThanDays != nil {
+		requested := time.Duration(*olderThanDays) * 24 * time.Hour
+		if requested < r.TrashRetention {
+			return time.Time{}, db.InvalidInput("deleted rows are kept for at least %s", r.TrashRetention)
+		}
+		olderThan = requested
+	}
+	return now.Add(-olderThan), nil
+}
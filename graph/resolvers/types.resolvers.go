@@ -0,0 +1,250 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/generated"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+)
+
+// Actor is the resolver for the actor field.
+func (r *auditEntryResolver) Actor(ctx context.Context, obj *model.AuditEntry) (*model.Employee, error) {
+	if obj.ActorID == nil {
+		return nil, nil
+	}
+
+	actor, err := r.loaders(ctx).EmployeeByID.Load(ctx, *obj.ActorID)
+	if err != nil || actor == nil {
+		return nil, err
+	}
+	return employeeToModel(actor), nil
+}
+
+// Author is the resolver for the author field.
+func (r *commentResolver) Author(ctx context.Context, obj *model.Comment) (*model.Employee, error) {
+	if obj.AuthorID == nil {
+		return nil, nil
+	}
+
+	author, err := r.loaders(ctx).EmployeeByID.Load(ctx, *obj.AuthorID)
+	if err != nil || author == nil {
+		return nil, err
+	}
+	return employeeToModel(author), nil
+}
+
+// Replies is the resolver for the replies field.
+func (r *commentResolver) Replies(ctx context.Context, obj *model.Comment) ([]*model.Comment, error) {
+	replies, err := r.loaders(ctx).RepliesByComment.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Comment, len(replies))
+	for i := range replies {
+		result[i] = commentToModel(&replies[i])
+	}
+	return result, nil
+}
+
+// Revisions is the resolver for the revisions field.
+func (r *commentResolver) Revisions(ctx context.Context, obj *model.Comment) ([]*model.CommentRevision, error) {
+	revisions, err := r.loaders(ctx).CommentRevisions.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.CommentRevision, len(revisions))
+	for i, revision := range revisions {
+		result[i] = &model.CommentRevision{ID: revision.ID, Body: revision.Body, CreatedAt: revision.CreatedAt}
+	}
+	return result, nil
+}
+
+// Projects is the resolver for the projects field.
+func (r *employeeResolver) Projects(ctx context.Context, obj *model.Employee) ([]*model.Project, error) {
+	projects, err := r.loaders(ctx).ProjectsByEmployee.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Project, len(projects))
+	for i := range projects {
+		result[i] = projectToModel(&projects[i])
+	}
+	return result, nil
+}
+
+// Manager is the resolver for the manager field.
+func (r *projectResolver) Manager(ctx context.Context, obj *model.Project) (*model.Employee, error) {
+	if obj.ManagerID == nil {
+		return nil, nil
+	}
+
+	manager, err := r.loaders(ctx).EmployeeByID.Load(ctx, *obj.ManagerID)
+	if err != nil || manager == nil {
+		return nil, err
+	}
+	return employeeToModel(manager), nil
+}
+
+// Teams is the resolver for the teams field.
+func (r *projectResolver) Teams(ctx context.Context, obj *model.Project) ([]*model.Team, error) {
+	teams, err := r.loaders(ctx).TeamsByProject.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Team, len(teams))
+	for i := range teams {
+		result[i] = teamToModel(&teams[i])
+	}
+	return result, nil
+}
+
+// Tickets is the resolver for the tickets field.
+func (r *projectResolver) Tickets(ctx context.Context, obj *model.Project) ([]*model.Ticket, error) {
+	tickets, err := r.loaders(ctx).TicketsByProject.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Ticket, len(tickets))
+	for i := range tickets {
+		result[i] = ticketToModel(&tickets[i])
+	}
+	return result, nil
+}
+
+// History is the resolver for the history field.
+func (r *projectResolver) History(ctx context.Context, obj *model.Project) ([]*model.AuditEntry, error) {
+	project := &db.Project{ID: obj.ID, ManagerID: obj.ManagerID}
+	if err := r.Policy.CanManageProject(ctx, middleware.GetUserFromContext(ctx), project); err != nil {
+		return nil, err
+	}
+
+	entries, err := r.loaders(ctx).ProjectHistory.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	return historyToModel(entries), nil
+}
+
+// History is the resolver for the history field.
+func (r *taskResolver) History(ctx context.Context, obj *model.Task) ([]*model.AuditEntry, error) {
+	if err := r.canViewHistory(ctx, "task", obj.ID, obj.AssignedToID, obj.ProjectID); err != nil {
+		return nil, err
+	}
+
+	entries, err := r.loaders(ctx).TaskHistory.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	return historyToModel(entries), nil
+}
+
+// Comments is the resolver for the comments field.
+func (r *taskResolver) Comments(ctx context.Context, obj *model.Task, sort []*model.SortInput, first *int, after *string, last *int, before *string) (*model.CommentConnection, error) {
+	page, err := r.Repos.Comments.ListByTask(ctx, obj.ID, db.PageArgs{First: first, After: after, Last: last, Before: before, Sort: sort})
+	if err != nil {
+		return nil, failure("failed to fetch comments", err)
+	}
+	return commentConnectionToModel(page), nil
+}
+
+// Leader is the resolver for the leader field.
+func (r *teamResolver) Leader(ctx context.Context, obj *model.Team) (*model.Employee, error) {
+	if obj.TeamLeaderID == nil {
+		return nil, nil
+	}
+
+	leader, err := r.loaders(ctx).EmployeeByID.Load(ctx, *obj.TeamLeaderID)
+	if err != nil || leader == nil {
+		return nil, err
+	}
+	return employeeToModel(leader), nil
+}
+
+// Engineers is the resolver for the engineers field.
+func (r *teamResolver) Engineers(ctx context.Context, obj *model.Team) ([]*model.Employee, error) {
+	engineers, err := r.loaders(ctx).EngineersByTeam.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Employee, len(engineers))
+	for i := range engineers {
+		result[i] = employeeToModel(&engineers[i])
+	}
+	return result, nil
+}
+
+// Assignee is the resolver for the assignee field.
+func (r *ticketResolver) Assignee(ctx context.Context, obj *model.Ticket) (*model.Employee, error) {
+	if obj.AssignedToID == nil {
+		return nil, nil
+	}
+
+	assignee, err := r.loaders(ctx).EmployeeByID.Load(ctx, *obj.AssignedToID)
+	if err != nil || assignee == nil {
+		return nil, err
+	}
+	return employeeToModel(assignee), nil
+}
+
+// History is the resolver for the history field.
+func (r *ticketResolver) History(ctx context.Context, obj *model.Ticket) ([]*model.AuditEntry, error) {
+	if err := r.canViewHistory(ctx, "ticket", obj.ID, obj.AssignedToID, &obj.ProjectID); err != nil {
+		return nil, err
+	}
+
+	entries, err := r.loaders(ctx).TicketHistory.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	return historyToModel(entries), nil
+}
+
+// Comments is the resolver for the comments field.
+func (r *ticketResolver) Comments(ctx context.Context, obj *model.Ticket, sort []*model.SortInput, first *int, after *string, last *int, before *string) (*model.CommentConnection, error) {
+	page, err := r.Repos.Comments.ListByTicket(ctx, obj.ID, db.PageArgs{First: first, After: after, Last: last, Before: before, Sort: sort})
+	if err != nil {
+		return nil, failure("failed to fetch comments", err)
+	}
+	return commentConnectionToModel(page), nil
+}
+
+// AuditEntry returns generated.AuditEntryResolver implementation.
+func (r *Resolver) AuditEntry() generated.AuditEntryResolver { return &auditEntryResolver{r} }
+
+// Comment returns generated.CommentResolver implementation.
+func (r *Resolver) Comment() generated.CommentResolver { return &commentResolver{r} }
+
+// Employee returns generated.EmployeeResolver implementation.
+func (r *Resolver) Employee() generated.EmployeeResolver { return &employeeResolver{r} }
+
+// Project returns generated.ProjectResolver implementation.
+func (r *Resolver) Project() generated.ProjectResolver { return &projectResolver{r} }
+
+// Task returns generated.TaskResolver implementation.
+func (r *Resolver) Task() generated.TaskResolver { return &taskResolver{r} }
+
+// Team returns generated.TeamResolver implementation.
+func (r *Resolver) Team() generated.TeamResolver { return &teamResolver{r} }
+
+// Ticket returns generated.TicketResolver implementation.
+func (r *Resolver) Ticket() generated.TicketResolver { return &ticketResolver{r} }
+
+type auditEntryResolver struct{ *Resolver }
+type commentResolver struct{ *Resolver }
+type employeeResolver struct{ *Resolver }
+type projectResolver struct{ *Resolver }
+type taskResolver struct{ *Resolver }
+type teamResolver struct{ *Resolver }
+type ticketResolver struct{ *Resolver }
@@ -0,0 +1,47 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"github.com/JonJenson-MFIn/project-management-system-api/repository"
+	"github.com/JonJenson-MFIn/project-management-system-api/workflow"
+)
+
+// workflowOf returns the workflow of a project, or workflow.Default when it has none or
+// the work is outside any project
+func (r *Resolver) workflowOf(ctx context.Context, projectID *int) (*db.Workflow, error) {
+	if projectID == nil {
+		return &workflow.Default, nil
+	}
+	w, err := r.Repos.Workflows.Get(ctx, *projectID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return &workflow.Default, nil
+	}
+	return w, err
+}
+
+// moveStatus moves work in projectID to status to, checking that the caller may make the
+// move under the project's workflow and keeping completedAt in step. New work, whose
+// status is still empty, may start in any state of the workflow.
+func (r *Resolver) moveStatus(ctx context.Context, projectID *int, status *db.StatusDB, completedAt **time.Time, to db.StatusDB) error {
+	w, err := r.workflowOf(ctx, projectID)
+	if err != nil {
+		return failure("failed to load workflow", err)
+	}
+	if err := workflow.CheckState(w, to); err != nil {
+		return err
+	}
+	if *status != "" {
+		if err := workflow.Check(w, middleware.GetUserFromContext(ctx), *status, to); err != nil {
+			return err
+		}
+	}
+
+	workflow.Stamp(completedAt, *status, to, time.Now())
+	*status = to
+	return nil
+}
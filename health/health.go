@@ -0,0 +1,55 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// Check is one dependency the service needs before it can take traffic
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+type report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// Live answers /healthz: the process is up and serving HTTP
+func Live() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, http.StatusOK, report{Status: "ok"})
+	})
+}
+
+// Ready answers /readyz with 200 when every check passes within timeout and 503
+// otherwise, listing each check's result
+func Ready(timeout time.Duration, checks ...Check) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		status := http.StatusOK
+		result := report{Status: "ok", Checks: make(map[string]string, len(checks))}
+		for _, check := range checks {
+			if err := check.Run(ctx); err != nil {
+				status = http.StatusServiceUnavailable
+				result.Status = "unavailable"
+				result.Checks[check.Name] = err.Error()
+				continue
+			}
+			result.Checks[check.Name] = "ok"
+		}
+		writeReport(w, status, result)
+	})
+}
+
+func writeReport(w http.ResponseWriter, status int, result report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,126 @@
+package loaders
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultWait is how long a loader collects keys before fetching them
+	DefaultWait = 2 * time.Millisecond
+	// DefaultMaxBatch caps the keys sent in one fetch
+	DefaultMaxBatch = 100
+)
+
+// FetchFunc loads the values of keys in one round trip. Keys missing from the map
+// resolve to the zero value.
+type FetchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// Loader batches the Load calls made within a short window into a single fetch and
+// caches the results, so it must live no longer than one request.
+type Loader[K comparable, V any] struct {
+	fetch    FetchFunc[K, V]
+	wait     time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	results map[K]*result[V]
+	batch   *batch[K, V]
+}
+
+type result[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+type batch[K comparable, V any] struct {
+	keys       []K
+	results    []*result[V]
+	dispatched bool
+}
+
+// NewLoader creates a Loader with the default wait and batch size
+func NewLoader[K comparable, V any](fetch FetchFunc[K, V]) *Loader[K, V] {
+	return &Loader[K, V]{
+		fetch:    fetch,
+		wait:     DefaultWait,
+		maxBatch: DefaultMaxBatch,
+		results:  map[K]*result[V]{},
+	}
+}
+
+// Load returns the value for key, joining the pending batch or starting a new one
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	r, ok := l.results[key]
+	if !ok {
+		r = &result[V]{done: make(chan struct{})}
+		l.results[key] = r
+		l.enqueue(ctx, key, r)
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-r.done:
+		return r.value, r.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// enqueue must be called with l.mu held
+func (l *Loader[K, V]) enqueue(ctx context.Context, key K, r *result[V]) {
+	if l.batch == nil {
+		b := &batch[K, V]{}
+		l.batch = b
+		time.AfterFunc(l.wait, func() { l.dispatch(ctx, b) })
+	}
+
+	b := l.batch
+	b.keys = append(b.keys, key)
+	b.results = append(b.results, r)
+	if len(b.keys) >= l.maxBatch {
+		l.batch = nil
+		go l.dispatch(ctx, b)
+	}
+}
+
+func (l *Loader[K, V]) dispatch(ctx context.Context, b *batch[K, V]) {
+	// A full batch is dispatched early, so its timer fires for a batch already sent
+	l.mu.Lock()
+	if b.dispatched {
+		l.mu.Unlock()
+		return
+	}
+	b.dispatched = true
+	if l.batch == b {
+		l.batch = nil
+	}
+	l.mu.Unlock()
+
+	values, err := l.fetchRecovered(context.WithoutCancel(ctx), b.keys)
+	for i, key := range b.keys {
+		r := b.results[i]
+		r.value, r.err = values[key], err
+		close(r.done)
+	}
+}
+
+// fetchRecovered turns a panic in fetch into an error for every key of the batch. The
+// fetch runs on a timer goroutine, where a panic would stop the server and leave the
+// waiting resolvers hanging.
+func (l *Loader[K, V]) fetchRecovered(ctx context.Context, keys []K) (values map[K]V, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			slog.ErrorContext(ctx, "loader fetch panicked", "panic", p, "stack", string(debug.Stack()))
+			values, err = nil, fmt.Errorf("loader fetch panicked: %v", p)
+		}
+	}()
+	return l.fetch(ctx, keys)
+}
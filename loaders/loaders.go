@@ -0,0 +1,124 @@
+package loaders
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"github.com/JonJenson-MFIn/project-management-system-api/repository"
+)
+
+type contextKey string
+
+const loadersCtxKey = contextKey("loaders")
+
+// Loaders holds the per-request DataLoaders behind the nested relationship fields
+type Loaders struct {
+	EmployeeByID       *Loader[int, *db.Employee]
+	TeamsByProject     *Loader[int, []db.Team]
+	TicketsByProject   *Loader[int, []db.Ticket]
+	EngineersByTeam    *Loader[int, []db.Employee]
+	ProjectsByEmployee *Loader[int, []db.Project]
+	ProjectHistory     *Loader[int, []db.AuditEntry]
+	TicketHistory      *Loader[int, []db.AuditEntry]
+	TaskHistory        *Loader[int, []db.AuditEntry]
+	RepliesByComment   *Loader[int, []db.Comment]
+	CommentRevisions   *Loader[int, []db.CommentRevision]
+}
+
+// New creates a fresh set of loaders. Ticket lists are scoped to the caller by the
+// repository, exactly like the tickets query.
+func New(repos *repository.Repositories) *Loaders {
+	// Audit entries name their entity by its GORM model
+	history := func(entityType string) *Loader[int, []db.AuditEntry] {
+		return NewLoader(func(ctx context.Context, ids []int) (map[int][]db.AuditEntry, error) {
+			entries, err := repos.Audit.ListByEntities(ctx, entityType, ids)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load %s history: %w", strings.ToLower(entityType), err)
+			}
+			return entries, nil
+		})
+	}
+
+	return &Loaders{
+		EmployeeByID: NewLoader(func(ctx context.Context, ids []int) (map[int]*db.Employee, error) {
+			employees, err := repos.Employees.GetMany(ctx, ids)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load employees: %w", err)
+			}
+			return employees, nil
+		}),
+
+		TeamsByProject: NewLoader(func(ctx context.Context, projectIDs []int) (map[int][]db.Team, error) {
+			teams, err := repos.Teams.ListByProjects(ctx, projectIDs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load project teams: %w", err)
+			}
+			return teams, nil
+		}),
+
+		TicketsByProject: NewLoader(func(ctx context.Context, projectIDs []int) (map[int][]db.Ticket, error) {
+			tickets, err := repos.Tickets.ListByProjects(ctx, middleware.GetUserFromContext(ctx), projectIDs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load tickets: %w", err)
+			}
+			return tickets, nil
+		}),
+
+		EngineersByTeam: NewLoader(func(ctx context.Context, teamIDs []int) (map[int][]db.Employee, error) {
+			engineers, err := repos.Employees.ListByTeams(ctx, teamIDs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load team engineers: %w", err)
+			}
+			return engineers, nil
+		}),
+
+		ProjectsByEmployee: NewLoader(func(ctx context.Context, employeeIDs []int) (map[int][]db.Project, error) {
+			projects, err := repos.Projects.ListByEmployees(ctx, employeeIDs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load employee projects: %w", err)
+			}
+			return projects, nil
+		}),
+
+		ProjectHistory: history("Project"),
+		TicketHistory:  history("Ticket"),
+		TaskHistory:    history("Task"),
+
+		RepliesByComment: NewLoader(func(ctx context.Context, commentIDs []int) (map[int][]db.Comment, error) {
+			replies, err := repos.Comments.ListReplies(ctx, commentIDs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load comment replies: %w", err)
+			}
+			return replies, nil
+		}),
+
+		CommentRevisions: NewLoader(func(ctx context.Context, commentIDs []int) (map[int][]db.CommentRevision, error) {
+			revisions, err := repos.Comments.ListRevisions(ctx, commentIDs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load comment revisions: %w", err)
+			}
+			return revisions, nil
+		}),
+	}
+}
+
+// Middleware attaches a fresh set of loaders to every request. It must run inside the
+// auth middleware so ticket scoping sees the caller.
+func Middleware(repos *repository.Repositories) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersCtxKey, New(repos))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// For returns the request's loaders, or nil outside Middleware
+func For(ctx context.Context) *Loaders {
+	l, _ := ctx.Value(loadersCtxKey).(*Loaders)
+	return l
+}
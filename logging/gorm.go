@@ -0,0 +1,81 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// GormLogger sends GORM's logs to slog with the statement's context, so SQL lines carry
+// the request ID. Statements are logged with placeholders, never bound values: failures
+// at error, slow statements at warn and everything else at debug.
+type GormLogger struct {
+	Logger        *slog.Logger
+	SlowThreshold time.Duration
+}
+
+var (
+	_ gormlogger.Interface = GormLogger{}
+	_ gorm.ParamsFilter    = GormLogger{}
+)
+
+// NewGormLogger creates a GormLogger that flags statements slower than slowThreshold
+func NewGormLogger(logger *slog.Logger, slowThreshold time.Duration) GormLogger {
+	return GormLogger{Logger: logger, SlowThreshold: slowThreshold}
+}
+
+// LogMode is ignored: the slog level decides what is written
+func (l GormLogger) LogMode(gormlogger.LogLevel) gormlogger.Interface {
+	return l
+}
+
+func (l GormLogger) Info(ctx context.Context, msg string, data ...interface{}) {
+	l.Logger.InfoContext(ctx, fmt.Sprintf(msg, data...))
+}
+
+func (l GormLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	l.Logger.WarnContext(ctx, fmt.Sprintf(msg, data...))
+}
+
+func (l GormLogger) Error(ctx context.Context, msg string, data ...interface{}) {
+	l.Logger.ErrorContext(ctx, fmt.Sprintf(msg, data...))
+}
+
+func (l GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	elapsed := time.Since(begin)
+
+	var level slog.Level
+	var msg string
+	switch {
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound):
+		level, msg = slog.LevelError, "query failed"
+	case l.SlowThreshold > 0 && elapsed > l.SlowThreshold:
+		level, msg = slog.LevelWarn, "slow query"
+	default:
+		level, msg = slog.LevelDebug, "query"
+	}
+	if !l.Logger.Enabled(ctx, level) {
+		return
+	}
+
+	sql, rows := fc()
+	attrs := []slog.Attr{
+		slog.String("sql", sql),
+		slog.Int64("rows", rows),
+		slog.Duration("elapsed", elapsed),
+	}
+	if level == slog.LevelError {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	l.Logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// ParamsFilter drops the bound values so passwords and tokens never reach the logs
+func (l GormLogger) ParamsFilter(ctx context.Context, sql string, params ...interface{}) (string, []interface{}) {
+	return sql, nil
+}
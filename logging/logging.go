@@ -0,0 +1,88 @@
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type contextKey struct{}
+
+// fields are the correlation values stamped on every log line of a request. The
+// employee is filled in by authentication after the request ID is set, so loggers
+// wrapping the whole request, such as the access log, see it too. Goroutines of the
+// request log while it is set, so mu guards it.
+type fields struct {
+	requestID string
+
+	mu         sync.Mutex
+	employeeID int
+}
+
+func (f *fields) employee() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.employeeID
+}
+
+// WithRequestID starts the correlation fields of a request
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, &fields{requestID: requestID})
+}
+
+// WithEmployeeID records the authenticated employee on the request's fields, or starts
+// new ones when the context has none. The fields are shared with every context derived
+// from the one WithRequestID returned, so this changes them in place rather than copying.
+func WithEmployeeID(ctx context.Context, employeeID int) context.Context {
+	if f, ok := ctx.Value(contextKey{}).(*fields); ok {
+		f.mu.Lock()
+		f.employeeID = employeeID
+		f.mu.Unlock()
+		return ctx
+	}
+	return context.WithValue(ctx, contextKey{}, &fields{employeeID: employeeID})
+}
+
+// RequestID returns the request's correlation ID, or "" outside a request
+func RequestID(ctx context.Context) string {
+	if f, ok := ctx.Value(contextKey{}).(*fields); ok {
+		return f.requestID
+	}
+	return ""
+}
+
+// New returns a JSON logger that adds request_id, employee_id, trace_id and span_id
+// from the context passed to its *Context methods
+func New(w io.Writer, level slog.Level) *slog.Logger {
+	return slog.New(contextHandler{slog.NewJSONHandler(w, &slog.HandlerOptions{Level: level})})
+}
+
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if f, ok := ctx.Value(contextKey{}).(*fields); ok {
+		if f.requestID != "" {
+			record.AddAttrs(slog.String("request_id", f.requestID))
+		}
+		if employeeID := f.employee(); employeeID != 0 {
+			record.AddAttrs(slog.Int("employee_id", employeeID))
+		}
+	}
+	if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+		record.AddAttrs(slog.String("trace_id", span.TraceID().String()), slog.String("span_id", span.SpanID().String()))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,146 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// unclassifiedCode labels errors that carry no extensions.code
+const unclassifiedCode = "INTERNAL"
+
+// Metrics records GraphQL and database pool metrics in its own registry. It is a gqlgen
+// extension: add it to the server with Use.
+type Metrics struct {
+	registry *prometheus.Registry
+	schema   graphql.ExecutableSchema
+
+	operationDuration *prometheus.HistogramVec
+	fieldDuration     *prometheus.HistogramVec
+	errors            *prometheus.CounterVec
+	complexity        *prometheus.HistogramVec
+}
+
+var (
+	_ graphql.HandlerExtension    = (*Metrics)(nil)
+	_ graphql.ResponseInterceptor = (*Metrics)(nil)
+	_ graphql.FieldInterceptor    = (*Metrics)(nil)
+)
+
+// New creates the collectors, along with the standard Go runtime and process ones
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		operationDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "graphql_operation_duration_seconds",
+			Help:    "Time from receiving a GraphQL operation to writing its response.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"type", "operation"}),
+		fieldDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "graphql_field_duration_seconds",
+			Help:    "Time spent in field resolvers.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"object", "field"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "graphql_errors_total",
+			Help: "GraphQL errors returned to clients, by extensions.code.",
+		}, []string{"operation", "code"}),
+		complexity: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "graphql_operation_complexity",
+			Help:    "Calculated complexity of GraphQL operations.",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 12),
+		}, []string{"type", "operation"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.operationDuration,
+		m.fieldDuration,
+		m.errors,
+		m.complexity,
+	)
+	return m
+}
+
+// RegisterDB exposes the pool's open, idle and in-use connections and wait counts
+func (m *Metrics) RegisterDB(sqlDB *sql.DB, name string) error {
+	return m.registry.Register(collectors.NewDBStatsCollector(sqlDB, name))
+}
+
+// Handler serves the registry in the Prometheus text format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+func (m *Metrics) ExtensionName() string {
+	return "Metrics"
+}
+
+func (m *Metrics) Validate(schema graphql.ExecutableSchema) error {
+	m.schema = schema
+	return nil
+}
+
+// InterceptResponse records latency, complexity and errors once the response is built.
+// Requests that fail to parse or validate have no operation and only count errors.
+func (m *Metrics) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+
+	operation := ""
+	if graphql.HasOperationContext(ctx) {
+		rc := graphql.GetOperationContext(ctx)
+		if rc.Operation != nil {
+			operation = operationName(rc)
+			kind := string(rc.Operation.Operation)
+			m.operationDuration.WithLabelValues(kind, operation).Observe(time.Since(rc.Stats.OperationStart).Seconds())
+			m.complexity.WithLabelValues(kind, operation).Observe(float64(complexity.Calculate(ctx, m.schema, rc.Operation, rc.Variables)))
+		}
+	}
+
+	if resp != nil {
+		for _, err := range resp.Errors {
+			m.errors.WithLabelValues(operation, errorCode(err)).Inc()
+		}
+	}
+	return resp
+}
+
+// InterceptField times fields backed by a resolver; plain struct fields are not worth
+// a histogram observation each
+func (m *Metrics) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	res, err := next(ctx)
+	m.fieldDuration.WithLabelValues(fc.Object, fc.Field.Name).Observe(time.Since(start).Seconds())
+	return res, err
+}
+
+func operationName(rc *graphql.OperationContext) string {
+	if rc.OperationName != "" {
+		return rc.OperationName
+	}
+	if rc.Operation.Name != "" {
+		return rc.Operation.Name
+	}
+	return "anonymous"
+}
+
+func errorCode(err *gqlerror.Error) string {
+	if code, ok := err.Extensions["code"].(string); ok && code != "" {
+		return code
+	}
+	return unclassifiedCode
+}
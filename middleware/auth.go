@@ -2,29 +2,124 @@ package middleware
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/auth"
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
 	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/JonJenson-MFIn/project-management-system-api/logging"
+	"github.com/JonJenson-MFIn/project-management-system-api/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type contextKey string
 
 const userCtxKey = contextKey("user")
 
-func AuthMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		role := r.Header.Get("X-User-Role") 
+// AuthMiddleware verifies the bearer access token and its session on the request and
+// stores the authenticated employee in the context. Requests without a token pass through
+// anonymously so that the @auth directive can reject them per field. Each request runs in
+// a server span continuing the caller's W3C trace context.
+func AuthMiddleware(sessions *auth.SessionManager) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+			ctx, span := tracing.Tracer().Start(ctx, r.Method+" "+r.URL.Path,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(semconv.HTTPRequestMethodKey.String(r.Method), semconv.URLPath(r.URL.Path)),
+			)
+			defer span.End()
 
-		user := &model.AuthUser{
-			ID:   "123", 
-			Role: model.Role(role),
-		}
+			recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			defer func() {
+				span.SetAttributes(semconv.HTTPResponseStatusCode(recorder.status))
+				if recorder.status >= http.StatusInternalServerError {
+					span.SetStatus(codes.Error, http.StatusText(recorder.status))
+				}
+			}()
+			w, r = recorder, r.WithContext(ctx)
 
-		ctx := context.WithValue(r.Context(), userCtxKey, user)
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+			header := r.Header.Get("Authorization")
+			if header == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			tokenString, ok := strings.CutPrefix(header, "Bearer ")
+			if !ok {
+				writeUnauthorized(w, "authorization header must use the Bearer scheme")
+				return
+			}
+
+			employee, claims, err := sessions.Authenticate(r.Context(), tokenString)
+			if errors.Is(err, auth.ErrAccountDeactivated) {
+				writeUnauthorized(w, "account is deactivated")
+				return
+			}
+			if err != nil {
+				writeUnauthorized(w, "invalid or expired access token")
+				return
+			}
+
+			user := &model.AuthUser{
+				ID:        employee.ID,
+				Role:      db.RoleToModel(employee.Role),
+				SessionID: claims.SessionID,
+			}
+			span.SetAttributes(semconv.EnduserID(strconv.Itoa(user.ID)))
+
+			next.ServeHTTP(w, r.WithContext(WithUser(r.Context(), user)))
+		})
+	}
 }
 
 func GetUserFromContext(ctx context.Context) *model.AuthUser {
 	user, _ := ctx.Value(userCtxKey).(*model.AuthUser)
 	return user
 }
+
+// WithUser returns a copy of ctx carrying the given user, who is also attached to every
+// log line of the request
+func WithUser(ctx context.Context, user *model.AuthUser) context.Context {
+	if user != nil {
+		ctx = logging.WithEmployeeID(ctx, user.ID)
+	}
+	return context.WithValue(ctx, userCtxKey, user)
+}
+
+// statusRecorder remembers the status code written to the client
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func writeUnauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", `Bearer realm="api"`)
+	w.WriteHeader(http.StatusUnauthorized)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"errors": []map[string]interface{}{
+			{
+				"message":    message,
+				"extensions": map[string]string{"code": "UNAUTHENTICATED"},
+			},
+		},
+	})
+}
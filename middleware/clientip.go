@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+const clientIPCtxKey = contextKey("clientIP")
+
+// ClientIP stores the caller's IP address in the request context. X-Forwarded-For is
+// only honoured when trustForwarded is set, i.e. when the service sits behind a proxy
+// that overwrites the header; otherwise clients could pick their own address.
+func ClientIP(trustForwarded bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := remoteIP(r.RemoteAddr)
+			if trustForwarded {
+				if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+					first, _, _ := strings.Cut(forwarded, ",")
+					ip = strings.TrimSpace(first)
+				}
+			}
+
+			ctx := context.WithValue(r.Context(), clientIPCtxKey, ip)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func GetClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPCtxKey).(string)
+	return ip
+}
+
+func remoteIP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/logging"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+// MaxRequestIDLength is the longest caller ID kept. The audit log stores request IDs, so
+// its column must be at least this wide.
+const MaxRequestIDLength = 128
+
+// Accepted IDs are short and free of characters that could forge log lines
+var validRequestID = regexp.MustCompile(fmt.Sprintf(`^[A-Za-z0-9._:-]{1,%d}$`, MaxRequestIDLength))
+
+// RequestID tags the request with the caller's X-Request-ID, or a fresh one when it is
+// missing or malformed, and echoes it in the response so clients can quote it
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(requestIDHeader)
+		if !validRequestID.MatchString(id) {
+			id = newRequestID()
+		}
+
+		w.Header().Set(requestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(logging.WithRequestID(r.Context(), id)))
+	})
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// AccessLog writes one line per request once the response is sent. It goes inside
+// RequestID and ClientIP so the line carries both.
+func AccessLog(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		slog.InfoContext(r.Context(), "request",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", recorder.status,
+			"duration", time.Since(start),
+			"client_ip", GetClientIPFromContext(r.Context()),
+		)
+	})
+}
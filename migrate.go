@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/auth"
+	"github.com/JonJenson-MFIn/project-management-system-api/config"
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/db/migrations"
+	"gorm.io/gorm"
+)
+
+const migrateUsage = "usage: migrate up | down | status | to <version>"
+
+// passwordMigration is the version that hashes passwords stored in plaintext
+const passwordMigration = 7
+
+// runMigrate implements the migrate subcommand
+func runMigrate(cfg *config.Config, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	migrator, err := migrations.New(db.DB)
+	if err != nil {
+		return err
+	}
+
+	// Only the cost matters: stored values are hashed as they are, without the policy
+	passwords := auth.NewPasswordHasher(cfg.Auth.BcryptCost, auth.PasswordPolicy{})
+	err = migrator.After(passwordMigration, func(tx *gorm.DB) error {
+		rehashed, err := auth.RehashPlaintextPasswords(tx, passwords)
+		if rehashed > 0 {
+			slog.Info("rehashed plaintext passwords", "count", rehashed)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	var steps []migrations.Step
+	switch {
+	case args[0] == "up" && len(args) == 1:
+		steps, err = migrator.Up(ctx)
+	case args[0] == "down" && len(args) == 1:
+		steps, err = migrator.Down(ctx)
+	case args[0] == "to" && len(args) == 2:
+		version, convErr := strconv.Atoi(args[1])
+		if convErr != nil {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		steps, err = migrator.To(ctx, version)
+	case args[0] == "status" && len(args) == 1:
+		return printMigrationStatus(ctx, migrator)
+	default:
+		return errors.New(migrateUsage)
+	}
+
+	for _, step := range steps {
+		direction := "applied"
+		if step.Down {
+			direction = "reverted"
+		}
+		fmt.Printf("%s %04d_%s\n", direction, step.Migration.Version, step.Migration.Name)
+	}
+	if err == nil && len(steps) == 0 {
+		fmt.Println("nothing to do")
+	}
+	return err
+}
+
+func printMigrationStatus(ctx context.Context, migrator *migrations.Migrator) error {
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATE\tAPPLIED AT")
+	for _, status := range statuses {
+		appliedAt := "-"
+		if status.AppliedAt != nil {
+			appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", status.Version, status.Name, status.State, appliedAt)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,377 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"gorm.io/gorm"
+)
+
+var ErrForbidden = errors.New("forbidden")
+
+// Directory answers the relationship questions that row-level policies depend on
+type Directory interface {
+	// ProjectManagerID returns the manager of a project, or nil if it has none or does not exist
+	ProjectManagerID(ctx context.Context, projectID int) (*int, error)
+	// LedEngineerIDs returns the engineers of every team the employee leads
+	LedEngineerIDs(ctx context.Context, leaderID int) ([]int, error)
+	// MemberProjectIDs returns the projects the employee belongs to or is assigned to
+	MemberProjectIDs(ctx context.Context, employeeID int) ([]int, error)
+	// ManagedProjectIDs returns the projects the employee manages
+	ManagedProjectIDs(ctx context.Context, managerID int) ([]int, error)
+	// EmployeeRole returns the role of an employee, or nil if they do not exist
+	EmployeeRole(ctx context.Context, employeeID int) (*model.Role, error)
+}
+
+// Engine decides which rows a caller may see and change.
+//
+//   - ADMIN may do anything.
+//   - MANAGER may change projects they manage and the tickets and tasks in them, and
+//     teams they lead.
+//   - TL may manage the members of teams they lead and the work assigned to those members.
+//   - EMPLOYEE may only move tickets and tasks assigned to them.
+//
+// Everyone may also work on tickets and tasks assigned to themselves, and see the work
+// of projects they belong to. Comments belong to their author: not even an ADMIN may
+// edit someone else's, only delete it.
+type Engine struct {
+	DB        *gorm.DB
+	Directory Directory
+}
+
+// NewEngine creates an Engine backed by the database
+func NewEngine(database *gorm.DB) *Engine {
+	return &Engine{
+		DB:        database,
+		Directory: gormDirectory{database},
+	}
+}
+
+// CanCreateProject checks that a MANAGER only creates projects they manage themselves
+func (e *Engine) CanCreateProject(ctx context.Context, user *model.AuthUser, managerID *int) error {
+	if user == nil {
+		return forbidden("authentication required")
+	}
+	if user.Role == model.RoleAdmin {
+		return nil
+	}
+	if managerID != nil && *managerID != user.ID {
+		return forbidden("managers may only create projects they manage")
+	}
+	return nil
+}
+
+// CanCreateTeam checks that a MANAGER only names themselves, a TL or an EMPLOYEE as
+// leader, so they do not create a team only someone else may change
+func (e *Engine) CanCreateTeam(ctx context.Context, user *model.AuthUser, leaderID *int) error {
+	if user == nil {
+		return forbidden("authentication required")
+	}
+	if user.Role == model.RoleAdmin || leaderID == nil || *leaderID == user.ID {
+		return nil
+	}
+	role, err := e.Directory.EmployeeRole(ctx, *leaderID)
+	if err != nil {
+		return err
+	}
+	if role != nil && !role.AtLeast(model.RoleManager) {
+		return nil
+	}
+	return forbidden("managers may only lead teams themselves or name a TL or employee as leader")
+}
+
+// CanManageProject checks that the caller may update or delete the project
+func (e *Engine) CanManageProject(ctx context.Context, user *model.AuthUser, project *db.Project) error {
+	if user == nil {
+		return forbidden("authentication required")
+	}
+	if user.Role == model.RoleAdmin {
+		return nil
+	}
+	if user.Role == model.RoleManager && isEmployee(project.ManagerID, user.ID) {
+		return nil
+	}
+	return forbidden("only the project's manager may change project %d", project.ID)
+}
+
+// CanManageTeam checks that the caller may update or delete the team
+func (e *Engine) CanManageTeam(ctx context.Context, user *model.AuthUser, team *db.Team) error {
+	if user == nil {
+		return forbidden("authentication required")
+	}
+	if user.Role == model.RoleAdmin {
+		return nil
+	}
+	if user.Role == model.RoleManager && isEmployee(team.TeamLeaderID, user.ID) {
+		return nil
+	}
+	return forbidden("only the team's leader may change team %d", team.ID)
+}
+
+// CanManageTeamMembers checks that the caller may add or remove engineers of the team
+func (e *Engine) CanManageTeamMembers(ctx context.Context, user *model.AuthUser, team *db.Team) error {
+	if user == nil {
+		return forbidden("authentication required")
+	}
+	if user.Role.AtLeast(model.RoleManager) {
+		return nil
+	}
+	if user.Role == model.RoleTl && isEmployee(team.TeamLeaderID, user.ID) {
+		return nil
+	}
+	return forbidden("only the team's leader may manage members of team %d", team.ID)
+}
+
+// CanModifyTicket checks that the caller may update or delete the ticket
+func (e *Engine) CanModifyTicket(ctx context.Context, user *model.AuthUser, ticket *db.Ticket) error {
+	projectID := ticket.ProjectID
+	ok, err := e.canModifyWork(ctx, user, ticket.AssignedToID, &projectID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return forbidden("ticket %d is not assigned to you", ticket.ID)
+	}
+	return nil
+}
+
+// CanModifyTask checks that the caller may update or delete the task
+func (e *Engine) CanModifyTask(ctx context.Context, user *model.AuthUser, task *db.Task) error {
+	ok, err := e.canModifyWork(ctx, user, task.AssignedToID, task.ProjectID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return forbidden("task %d is not assigned to you", task.ID)
+	}
+	return nil
+}
+
+// CanEditComment checks that the caller wrote the comment
+func (e *Engine) CanEditComment(ctx context.Context, user *model.AuthUser, comment *db.Comment) error {
+	if user == nil {
+		return forbidden("authentication required")
+	}
+	if isEmployee(comment.AuthorID, user.ID) {
+		return nil
+	}
+	return forbidden("only the author may edit comment %d", comment.ID)
+}
+
+// CanDeleteComment checks that the caller wrote the comment or is an ADMIN
+func (e *Engine) CanDeleteComment(ctx context.Context, user *model.AuthUser, comment *db.Comment) error {
+	if user == nil {
+		return forbidden("authentication required")
+	}
+	if user.Role == model.RoleAdmin || isEmployee(comment.AuthorID, user.ID) {
+		return nil
+	}
+	return forbidden("only the author may delete comment %d", comment.ID)
+}
+
+// CanAccessNotifications checks that the caller may read or acknowledge an employee's notifications
+func (e *Engine) CanAccessNotifications(ctx context.Context, user *model.AuthUser, employeeID int) error {
+	if user == nil {
+		return forbidden("authentication required")
+	}
+	if user.Role == model.RoleAdmin || user.ID == employeeID {
+		return nil
+	}
+	return forbidden("notifications of employee %d belong to someone else", employeeID)
+}
+
+// CanViewWork reports whether a ticket or task is visible to the caller. It applies the
+// same rules as ScopeTickets and ScopeTasks for rows that are already loaded.
+func (e *Engine) CanViewWork(ctx context.Context, user *model.AuthUser, assignedToID *int, projectID *int) (bool, error) {
+	if user == nil {
+		return false, nil
+	}
+	if user.Role == model.RoleAdmin || isEmployee(assignedToID, user.ID) {
+		return true, nil
+	}
+
+	if projectID != nil {
+		projectIDs, err := e.Directory.MemberProjectIDs(ctx, user.ID)
+		if err != nil {
+			return false, err
+		}
+		if user.Role == model.RoleManager {
+			managed, err := e.Directory.ManagedProjectIDs(ctx, user.ID)
+			if err != nil {
+				return false, err
+			}
+			projectIDs = append(projectIDs, managed...)
+		}
+		if slices.Contains(projectIDs, *projectID) {
+			return true, nil
+		}
+	}
+
+	if user.Role == model.RoleTl && assignedToID != nil {
+		engineerIDs, err := e.Directory.LedEngineerIDs(ctx, user.ID)
+		if err != nil {
+			return false, err
+		}
+		return slices.Contains(engineerIDs, *assignedToID), nil
+	}
+
+	return false, nil
+}
+
+// ScopeTickets restricts a tickets query to the rows the caller may see
+func (e *Engine) ScopeTickets(ctx context.Context, user *model.AuthUser, query *gorm.DB) *gorm.DB {
+	return e.scopeWork(ctx, user, query, "tickets")
+}
+
+// ScopeTasks restricts a tasks query to the rows the caller may see
+func (e *Engine) ScopeTasks(ctx context.Context, user *model.AuthUser, query *gorm.DB) *gorm.DB {
+	return e.scopeWork(ctx, user, query, "tasks")
+}
+
+func (e *Engine) canModifyWork(ctx context.Context, user *model.AuthUser, assignedToID *int, projectID *int) (bool, error) {
+	if user == nil {
+		return false, nil
+	}
+	if user.Role == model.RoleAdmin || isEmployee(assignedToID, user.ID) {
+		return true, nil
+	}
+
+	switch user.Role {
+	case model.RoleManager:
+		if projectID == nil {
+			return false, nil
+		}
+		managerID, err := e.Directory.ProjectManagerID(ctx, *projectID)
+		if err != nil {
+			return false, err
+		}
+		return isEmployee(managerID, user.ID), nil
+
+	case model.RoleTl:
+		if assignedToID == nil {
+			return false, nil
+		}
+		engineerIDs, err := e.Directory.LedEngineerIDs(ctx, user.ID)
+		if err != nil {
+			return false, err
+		}
+		for _, id := range engineerIDs {
+			if id == *assignedToID {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func (e *Engine) scopeWork(ctx context.Context, user *model.AuthUser, query *gorm.DB, table string) *gorm.DB {
+	if user == nil {
+		return query.Where("1 = 0")
+	}
+	if user.Role == model.RoleAdmin {
+		return query
+	}
+
+	database := e.DB.WithContext(ctx)
+	memberProjects := database.Model(&db.ProjectEmployee{}).Select("project_id").Where("employee_id = ?", user.ID)
+	assignedProject := database.Model(&db.Employee{}).Select("project_assigned_id").Where("id = ? AND project_assigned_id IS NOT NULL", user.ID)
+
+	conditions := database.Where(table+".assigned_to_id = ?", user.ID).
+		Or(table+".project_id IN (?)", memberProjects).
+		Or(table+".project_id IN (?)", assignedProject)
+
+	switch user.Role {
+	case model.RoleManager:
+		managedProjects := database.Model(&db.Project{}).Select("id").Where("manager_id = ?", user.ID)
+		conditions = conditions.Or(table+".project_id IN (?)", managedProjects)
+	case model.RoleTl:
+		ledEngineers := database.Model(&db.TeamEngineer{}).Select("team_engineers.engineer_id").
+			Joins("JOIN teams ON teams.id = team_engineers.team_id").
+			Where("teams.team_leader_id = ? AND teams.deleted_at IS NULL", user.ID)
+		conditions = conditions.Or(table+".assigned_to_id IN (?)", ledEngineers)
+	}
+
+	return query.Where(conditions)
+}
+
+func isEmployee(id *int, employeeID int) bool {
+	return id != nil && *id == employeeID
+}
+
+func forbidden(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrForbidden, fmt.Sprintf(format, args...))
+}
+
+type gormDirectory struct {
+	db *gorm.DB
+}
+
+func (d gormDirectory) ProjectManagerID(ctx context.Context, projectID int) (*int, error) {
+	var project db.Project
+	if err := d.db.WithContext(ctx).Select("id", "manager_id").First(&project, projectID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to load project manager: %w", err)
+	}
+	return project.ManagerID, nil
+}
+
+func (d gormDirectory) LedEngineerIDs(ctx context.Context, leaderID int) ([]int, error) {
+	var ids []int
+	if err := d.db.WithContext(ctx).Model(&db.TeamEngineer{}).
+		Joins("JOIN teams ON teams.id = team_engineers.team_id").
+		Where("teams.team_leader_id = ? AND teams.deleted_at IS NULL", leaderID).
+		Pluck("team_engineers.engineer_id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to load team members: %w", err)
+	}
+	return ids, nil
+}
+
+func (d gormDirectory) MemberProjectIDs(ctx context.Context, employeeID int) ([]int, error) {
+	var ids []int
+	if err := d.db.WithContext(ctx).Model(&db.ProjectEmployee{}).
+		Where("employee_id = ?", employeeID).
+		Pluck("project_id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to load project memberships: %w", err)
+	}
+
+	var employee db.Employee
+	if err := d.db.WithContext(ctx).Select("id", "project_assigned_id").First(&employee, employeeID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ids, nil
+		}
+		return nil, fmt.Errorf("failed to load assigned project: %w", err)
+	}
+	if employee.ProjectAssignedID != nil {
+		ids = append(ids, *employee.ProjectAssignedID)
+	}
+	return ids, nil
+}
+
+func (d gormDirectory) ManagedProjectIDs(ctx context.Context, managerID int) ([]int, error) {
+	var ids []int
+	if err := d.db.WithContext(ctx).Model(&db.Project{}).
+		Where("manager_id = ?", managerID).
+		Pluck("id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to load managed projects: %w", err)
+	}
+	return ids, nil
+}
+
+func (d gormDirectory) EmployeeRole(ctx context.Context, employeeID int) (*model.Role, error) {
+	var employee db.Employee
+	if err := d.db.WithContext(ctx).Select("id", "role").First(&employee, employeeID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to load employee role: %w", err)
+	}
+	role := db.RoleToModel(employee.Role)
+	return &role, nil
+}
@@ -0,0 +1,1438 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/JonJenson-MFIn/project-management-system-api/policy"
+	"gorm.io/gorm"
+)
+
+// Memory is an in-process store for tests and local tools. It applies the same filters,
+// sort whitelists, pagination and visibility rules as the Postgres repositories, and
+// doubles as the policy.Directory for them.
+type Memory struct {
+	mu               sync.Mutex
+	nextID           int
+	employees        map[int]db.Employee
+	projects         map[int]db.Project
+	teams            map[int]db.Team
+	tickets          map[int]db.Ticket
+	tasks            map[int]db.Task
+	notifications    map[int]db.Notification
+	workflows        map[int]db.Workflow
+	auditEntries     map[int]db.AuditEntry
+	comments         map[int]db.Comment
+	commentRevisions map[int]db.CommentRevision
+	teamEngineers    []db.TeamEngineer
+	projectTeams     []db.ProjectTeam
+	projectEmployees []db.ProjectEmployee
+	trash            trash
+}
+
+// trash holds soft-deleted rows, stamped with when they were deleted, apart from the live
+// tables so reads of those need no filtering
+type trash struct {
+	employees        map[int]db.Employee
+	projects         map[int]db.Project
+	teams            map[int]db.Team
+	tickets          map[int]db.Ticket
+	tasks            map[int]db.Task
+	comments         map[int]db.Comment
+	teamEngineers    []db.TeamEngineer
+	projectTeams     []db.ProjectTeam
+	projectEmployees []db.ProjectEmployee
+}
+
+// NewMemory creates an empty store
+func NewMemory() *Memory {
+	return &Memory{
+		trash: trash{
+			employees: make(map[int]db.Employee),
+			projects:  make(map[int]db.Project),
+			teams:     make(map[int]db.Team),
+			tickets:   make(map[int]db.Ticket),
+			tasks:     make(map[int]db.Task),
+			comments:  make(map[int]db.Comment),
+		},
+		employees:        make(map[int]db.Employee),
+		projects:         make(map[int]db.Project),
+		teams:            make(map[int]db.Team),
+		tickets:          make(map[int]db.Ticket),
+		tasks:            make(map[int]db.Task),
+		notifications:    make(map[int]db.Notification),
+		workflows:        make(map[int]db.Workflow),
+		auditEntries:     make(map[int]db.AuditEntry),
+		comments:         make(map[int]db.Comment),
+		commentRevisions: make(map[int]db.CommentRevision),
+	}
+}
+
+// Repositories returns repositories backed by the store
+func (m *Memory) Repositories() *Repositories {
+	engine := &policy.Engine{Directory: m}
+	return &Repositories{
+		Employees:     memEmployees{m},
+		Projects:      memProjects{m},
+		Teams:         memTeams{m},
+		Tickets:       memTickets{m, engine},
+		Tasks:         memTasks{m, engine},
+		Notifications: memNotifications{m},
+		Workflows:     memWorkflows{m},
+		Audit:         memAudit{m},
+		Comments:      memComments{m},
+	}
+}
+
+// ProjectManagerID implements policy.Directory
+func (m *Memory) ProjectManagerID(ctx context.Context, projectID int) (*int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	project, ok := m.projects[projectID]
+	if !ok {
+		return nil, nil
+	}
+	return project.ManagerID, nil
+}
+
+// LedEngineerIDs implements policy.Directory
+func (m *Memory) LedEngineerIDs(ctx context.Context, leaderID int) ([]int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var ids []int
+	for _, link := range m.teamEngineers {
+		if team, ok := m.teams[link.TeamID]; ok && isID(team.TeamLeaderID, leaderID) {
+			ids = append(ids, link.EngineerID)
+		}
+	}
+	return ids, nil
+}
+
+// MemberProjectIDs implements policy.Directory
+func (m *Memory) MemberProjectIDs(ctx context.Context, employeeID int) ([]int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var ids []int
+	for _, link := range m.projectEmployees {
+		if link.EmployeeID == employeeID {
+			ids = append(ids, link.ProjectID)
+		}
+	}
+	if employee, ok := m.employees[employeeID]; ok && employee.ProjectAssignedID != nil {
+		ids = append(ids, *employee.ProjectAssignedID)
+	}
+	return ids, nil
+}
+
+// ManagedProjectIDs implements policy.Directory
+func (m *Memory) ManagedProjectIDs(ctx context.Context, managerID int) ([]int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var ids []int
+	for id, project := range m.projects {
+		if isID(project.ManagerID, managerID) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// EmployeeRole implements policy.Directory
+func (m *Memory) EmployeeRole(ctx context.Context, employeeID int) (*model.Role, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	employee, ok := m.employees[employeeID]
+	if !ok {
+		return nil, nil
+	}
+	role := db.RoleToModel(employee.Role)
+	return &role, nil
+}
+
+func (m *Memory) id() int {
+	m.nextID++
+	return m.nextID
+}
+
+func isID(id *int, want int) bool {
+	return id != nil && *id == want
+}
+
+// values returns the rows of a table that match keep, in id order
+func values[T any](table map[int]T, keep func(T) bool) []T {
+	ids := make([]int, 0, len(table))
+	for id := range table {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	rows := make([]T, 0, len(ids))
+	for _, id := range ids {
+		if keep(table[id]) {
+			rows = append(rows, table[id])
+		}
+	}
+	return rows
+}
+
+func get[T any](table map[int]T, id int) (*T, error) {
+	row, ok := table[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &row, nil
+}
+
+// deletion points at the DeletedAt of a row
+type deletion[T any] func(row *T) *gorm.DeletedAt
+
+func employeeDeletion(e *db.Employee) *gorm.DeletedAt               { return &e.DeletedAt }
+func projectDeletion(p *db.Project) *gorm.DeletedAt                 { return &p.DeletedAt }
+func teamDeletion(t *db.Team) *gorm.DeletedAt                       { return &t.DeletedAt }
+func ticketDeletion(t *db.Ticket) *gorm.DeletedAt                   { return &t.DeletedAt }
+func taskDeletion(t *db.Task) *gorm.DeletedAt                       { return &t.DeletedAt }
+func commentDeletion(c *db.Comment) *gorm.DeletedAt                 { return &c.DeletedAt }
+func teamEngineerDeletion(l *db.TeamEngineer) *gorm.DeletedAt       { return &l.DeletedAt }
+func projectTeamDeletion(l *db.ProjectTeam) *gorm.DeletedAt         { return &l.DeletedAt }
+func projectEmployeeDeletion(l *db.ProjectEmployee) *gorm.DeletedAt { return &l.DeletedAt }
+
+// discard moves the live rows matching match to the trash, deleted at deletedAt, and
+// reports whether there were any
+func discard[T any](live, trashed map[int]T, match func(T) bool, deletedAt time.Time, stamp deletion[T]) bool {
+	found := false
+	for id, row := range live {
+		if !match(row) {
+			continue
+		}
+		*stamp(&row) = gorm.DeletedAt{Time: deletedAt, Valid: true}
+		trashed[id] = row
+		delete(live, id)
+		found = true
+	}
+	return found
+}
+
+// revive moves the trashed rows matching match that were deleted at deletedAt back
+func revive[T any](live, trashed map[int]T, match func(T) bool, deletedAt time.Time, stamp deletion[T]) {
+	for id, row := range trashed {
+		if !match(row) || !stamp(&row).Time.Equal(deletedAt) {
+			continue
+		}
+		*stamp(&row) = gorm.DeletedAt{}
+		live[id] = row
+		delete(trashed, id)
+	}
+}
+
+// expire drops trashed rows deleted before cutoff and returns their ids
+func expire[T any](trashed map[int]T, cutoff time.Time, stamp deletion[T]) []int {
+	var ids []int
+	for id, row := range trashed {
+		if stamp(&row).Time.Before(cutoff) {
+			ids = append(ids, id)
+			delete(trashed, id)
+		}
+	}
+	return ids
+}
+
+// drop removes the rows of each table matching gone, as a cascading foreign key would
+func drop[T any](gone func(T) bool, tables ...map[int]T) {
+	for _, table := range tables {
+		for id, row := range table {
+			if gone(row) {
+				delete(table, id)
+			}
+		}
+	}
+}
+
+// discardLinks is discard for a junction table
+func discardLinks[L any](live, trashed *[]L, match func(L) bool, deletedAt time.Time, stamp deletion[L]) {
+	*live = slices.DeleteFunc(*live, func(link L) bool {
+		if !match(link) {
+			return false
+		}
+		*stamp(&link) = gorm.DeletedAt{Time: deletedAt, Valid: true}
+		*trashed = append(*trashed, link)
+		return true
+	})
+}
+
+// reviveLinks is revive for a junction table
+func reviveLinks[L any](live, trashed *[]L, match func(L) bool, deletedAt time.Time, stamp deletion[L]) {
+	*trashed = slices.DeleteFunc(*trashed, func(link L) bool {
+		if !match(link) || !stamp(&link).Time.Equal(deletedAt) {
+			return false
+		}
+		*stamp(&link) = gorm.DeletedAt{}
+		*live = append(*live, link)
+		return true
+	})
+}
+
+// expireLinks drops trashed links deleted before cutoff
+func expireLinks[L any](trashed *[]L, cutoff time.Time, stamp deletion[L]) {
+	*trashed = slices.DeleteFunc(*trashed, func(link L) bool { return stamp(&link).Time.Before(cutoff) })
+}
+
+// dropLinks is drop for a junction table
+func dropLinks[L any](gone func(L) bool, tables ...*[]L) {
+	for _, table := range tables {
+		*table = slices.DeleteFunc(*table, gone)
+	}
+}
+
+// present reports whether a nullable reference is unset or found in one of tables
+func present[T any](id *int, tables ...map[int]T) bool {
+	if id == nil {
+		return true
+	}
+	for _, table := range tables {
+		if _, ok := table[*id]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// dropOrphanComments removes the comments whose ticket, task or parent comment is gone
+// for good, and their revisions, as the cascading foreign keys would
+func (m *Memory) dropOrphanComments() {
+	for orphaned := true; orphaned; {
+		orphaned = false
+		drop(func(c db.Comment) bool {
+			gone := !present(c.TicketID, m.tickets, m.trash.tickets) ||
+				!present(c.TaskID, m.tasks, m.trash.tasks) ||
+				!present(c.ParentID, m.comments, m.trash.comments)
+			orphaned = orphaned || gone
+			return gone
+		}, m.comments, m.trash.comments)
+	}
+	drop(func(r db.CommentRevision) bool {
+		return !present(&r.CommentID, m.comments, m.trash.comments)
+	}, m.commentRevisions)
+}
+
+// projectComment matches the comments on the tickets and tasks of a project, live or
+// trashed, as the subqueries of projectDependents do
+func (m *Memory) projectComment(projectID int) func(db.Comment) bool {
+	return func(c db.Comment) bool {
+		if c.TicketID != nil {
+			ticket, ok := m.tickets[*c.TicketID]
+			if !ok {
+				ticket, ok = m.trash.tickets[*c.TicketID]
+			}
+			return ok && ticket.ProjectID == projectID
+		}
+		if c.TaskID != nil {
+			task, ok := m.tasks[*c.TaskID]
+			if !ok {
+				task, ok = m.trash.tasks[*c.TaskID]
+			}
+			return ok && isID(task.ProjectID, projectID)
+		}
+		return false
+	}
+}
+
+// contains mirrors the ILIKE filter
+func contains(value string, filter *string) bool {
+	return filter == nil || *filter == "" || strings.Contains(strings.ToLower(value), strings.ToLower(*filter))
+}
+
+func equals[T comparable](value T, filter *T) bool {
+	return filter == nil || value == *filter
+}
+
+func equalsID(value *int, filter *int) bool {
+	return filter == nil || isID(value, *filter)
+}
+
+func between(value time.Time, after *time.Time, before *time.Time) bool {
+	return (after == nil || !value.Before(*after)) && (before == nil || !value.After(*before))
+}
+
+// dateRange parses the bounds of a Date filter like db.DateBetween does
+func dateRange(after *string, before *string) ([2]*time.Time, error) {
+	var bounds [2]*time.Time
+	for i, bound := range []*string{after, before} {
+		if bound == nil {
+			continue
+		}
+		date, err := time.Parse("2006-01-02", *bound)
+		if err != nil {
+			return bounds, db.InvalidInput("invalid date %q: expected YYYY-MM-DD", *bound)
+		}
+		bounds[i] = &date
+	}
+	return bounds, nil
+}
+
+// dateBetween matches a nullable date against parsed bounds; like SQL, a missing date
+// never matches a bound
+func dateBetween(value *time.Time, bounds [2]*time.Time) bool {
+	if bounds[0] == nil && bounds[1] == nil {
+		return true
+	}
+	return value != nil && between(*value, bounds[0], bounds[1])
+}
+
+type memEmployees struct {
+	m *Memory
+}
+
+func (r memEmployees) List(ctx context.Context, filter *model.EmployeeFilter, page db.PageArgs) (*db.Page[db.Employee], error) {
+	r.m.mu.Lock()
+	rows := values(r.m.employees, func(e db.Employee) bool {
+		if filter == nil {
+			return true
+		}
+		return contains(e.Name, filter.Name) &&
+			contains(e.Email, filter.Email) &&
+			(filter.Role == nil || e.Role == db.RoleToDB(*filter.Role)) &&
+			equals(e.Active, filter.Active) &&
+			equalsID(e.ProjectAssignedID, filter.ProjectAssignedID) &&
+			between(e.CreatedAt, filter.CreatedAtAfter, filter.CreatedAtBefore)
+	})
+	r.m.mu.Unlock()
+	return db.PaginateSlice(rows, db.EmployeeSorts, page)
+}
+
+func (r memEmployees) Get(ctx context.Context, id int) (*db.Employee, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	return get(r.m.employees, id)
+}
+
+func (r memEmployees) GetByEmail(ctx context.Context, email string) (*db.Employee, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	for _, employee := range r.m.employees {
+		if employee.Email == email {
+			return &employee, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (r memEmployees) GetMany(ctx context.Context, ids []int) (map[int]*db.Employee, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	result := make(map[int]*db.Employee, len(ids))
+	for _, id := range ids {
+		if employee, ok := r.m.employees[id]; ok {
+			result[id] = &employee
+		}
+	}
+	return result, nil
+}
+
+func (r memEmployees) ListByProject(ctx context.Context, projectID int) ([]db.Employee, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	return values(r.m.employees, func(e db.Employee) bool { return isID(e.ProjectAssignedID, projectID) }), nil
+}
+
+func (r memEmployees) ListByTeams(ctx context.Context, teamIDs []int) (map[int][]db.Employee, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	result := make(map[int][]db.Employee)
+	for _, employee := range values(r.m.employees, func(db.Employee) bool { return true }) {
+		for _, link := range r.m.teamEngineers {
+			if link.EngineerID == employee.ID && slices.Contains(teamIDs, link.TeamID) {
+				result[link.TeamID] = append(result[link.TeamID], employee)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (r memEmployees) Create(ctx context.Context, employee *db.Employee) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	for _, existing := range r.m.employees {
+		if existing.Email == employee.Email {
+			return db.Conflict("employee with email %q already exists", employee.Email)
+		}
+	}
+	now := time.Now()
+	employee.ID = r.m.id()
+	employee.CreatedAt, employee.UpdatedAt = now, now
+	r.m.employees[employee.ID] = *employee
+	return nil
+}
+
+// Save has no sessions to end: the in-memory store does not issue any
+func (r memEmployees) Save(ctx context.Context, employee *db.Employee) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	if _, ok := r.m.employees[employee.ID]; !ok {
+		return ErrNotFound
+	}
+	employee.UpdatedAt = time.Now()
+	r.m.employees[employee.ID] = *employee
+	return nil
+}
+
+func (r memEmployees) Delete(ctx context.Context, employee *db.Employee) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	now := time.Now()
+	if !discard(r.m.employees, r.m.trash.employees, func(e db.Employee) bool { return e.ID == employee.ID }, now, employeeDeletion) {
+		return ErrNotFound
+	}
+	discardLinks(&r.m.teamEngineers, &r.m.trash.teamEngineers, func(l db.TeamEngineer) bool { return l.EngineerID == employee.ID }, now, teamEngineerDeletion)
+	discardLinks(&r.m.projectEmployees, &r.m.trash.projectEmployees, func(l db.ProjectEmployee) bool { return l.EmployeeID == employee.ID }, now, projectEmployeeDeletion)
+	employee.DeletedAt = gorm.DeletedAt{Time: now, Valid: true}
+	return nil
+}
+
+func (r memEmployees) ListTrashed(ctx context.Context, page db.PageArgs) (*db.Page[db.Employee], error) {
+	r.m.mu.Lock()
+	rows := values(r.m.trash.employees, func(db.Employee) bool { return true })
+	r.m.mu.Unlock()
+	return db.PaginateSlice(rows, db.EmployeeSorts, page)
+}
+
+func (r memEmployees) GetTrashed(ctx context.Context, id int) (*db.Employee, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	return get(r.m.trash.employees, id)
+}
+
+func (r memEmployees) Restore(ctx context.Context, employee *db.Employee) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	trashed, ok := r.m.trash.employees[employee.ID]
+	if !ok {
+		return ErrNotFound
+	}
+	deletedAt := trashed.DeletedAt.Time
+	revive(r.m.employees, r.m.trash.employees, func(e db.Employee) bool { return e.ID == employee.ID }, deletedAt, employeeDeletion)
+	reviveLinks(&r.m.teamEngineers, &r.m.trash.teamEngineers, func(l db.TeamEngineer) bool { return l.EngineerID == employee.ID }, deletedAt, teamEngineerDeletion)
+	reviveLinks(&r.m.projectEmployees, &r.m.trash.projectEmployees, func(l db.ProjectEmployee) bool { return l.EmployeeID == employee.ID }, deletedAt, projectEmployeeDeletion)
+	employee.DeletedAt = gorm.DeletedAt{}
+	return nil
+}
+
+func (r memEmployees) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	ids := expire(r.m.trash.employees, cutoff, employeeDeletion)
+	dropLinks(func(l db.TeamEngineer) bool { return slices.Contains(ids, l.EngineerID) }, &r.m.teamEngineers, &r.m.trash.teamEngineers)
+	dropLinks(func(l db.ProjectEmployee) bool { return slices.Contains(ids, l.EmployeeID) }, &r.m.projectEmployees, &r.m.trash.projectEmployees)
+	return int64(len(ids)), nil
+}
+
+type memProjects struct {
+	m *Memory
+}
+
+func (r memProjects) List(ctx context.Context, filter *model.ProjectFilter, page db.PageArgs) (*db.Page[db.Project], error) {
+	r.m.mu.Lock()
+	rows := values(r.m.projects, func(p db.Project) bool {
+		if filter == nil {
+			return true
+		}
+		return contains(p.Name, filter.Name) &&
+			(filter.Status == nil || p.Status == db.StatusToDB(*filter.Status)) &&
+			equalsID(p.ManagerID, filter.ManagerID) &&
+			between(p.StartDate, filter.StartDateAfter, filter.StartDateBefore) &&
+			between(p.CreatedAt, filter.CreatedAtAfter, filter.CreatedAtBefore)
+	})
+	r.m.mu.Unlock()
+	return db.PaginateSlice(rows, db.ProjectSorts, page)
+}
+
+func (r memProjects) Get(ctx context.Context, id int) (*db.Project, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	return get(r.m.projects, id)
+}
+
+func (r memProjects) ListByEmployees(ctx context.Context, employeeIDs []int) (map[int][]db.Project, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	result := make(map[int][]db.Project)
+	for _, project := range values(r.m.projects, func(db.Project) bool { return true }) {
+		for _, link := range r.m.projectEmployees {
+			if link.ProjectID == project.ID && slices.Contains(employeeIDs, link.EmployeeID) {
+				result[link.EmployeeID] = append(result[link.EmployeeID], project)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (r memProjects) Create(ctx context.Context, project *db.Project) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	now := time.Now()
+	project.ID = r.m.id()
+	project.CreatedAt, project.UpdatedAt = now, now
+	r.m.projects[project.ID] = *project
+	return nil
+}
+
+func (r memProjects) Save(ctx context.Context, project *db.Project) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	if _, ok := r.m.projects[project.ID]; !ok {
+		return ErrNotFound
+	}
+	project.UpdatedAt = time.Now()
+	r.m.projects[project.ID] = *project
+	return nil
+}
+
+func (r memProjects) Delete(ctx context.Context, project *db.Project) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	now := time.Now()
+	if !discard(r.m.projects, r.m.trash.projects, func(p db.Project) bool { return p.ID == project.ID }, now, projectDeletion) {
+		return ErrNotFound
+	}
+	discard(r.m.tickets, r.m.trash.tickets, func(t db.Ticket) bool { return t.ProjectID == project.ID }, now, ticketDeletion)
+	discard(r.m.tasks, r.m.trash.tasks, func(t db.Task) bool { return isID(t.ProjectID, project.ID) }, now, taskDeletion)
+	discard(r.m.comments, r.m.trash.comments, r.m.projectComment(project.ID), now, commentDeletion)
+	discardLinks(&r.m.projectTeams, &r.m.trash.projectTeams, func(l db.ProjectTeam) bool { return l.ProjectID == project.ID }, now, projectTeamDeletion)
+	discardLinks(&r.m.projectEmployees, &r.m.trash.projectEmployees, func(l db.ProjectEmployee) bool { return l.ProjectID == project.ID }, now, projectEmployeeDeletion)
+	project.DeletedAt = gorm.DeletedAt{Time: now, Valid: true}
+	return nil
+}
+
+func (r memProjects) ListTrashed(ctx context.Context, page db.PageArgs) (*db.Page[db.Project], error) {
+	r.m.mu.Lock()
+	rows := values(r.m.trash.projects, func(db.Project) bool { return true })
+	r.m.mu.Unlock()
+	return db.PaginateSlice(rows, db.ProjectSorts, page)
+}
+
+func (r memProjects) GetTrashed(ctx context.Context, id int) (*db.Project, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	return get(r.m.trash.projects, id)
+}
+
+func (r memProjects) Restore(ctx context.Context, project *db.Project) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	trashed, ok := r.m.trash.projects[project.ID]
+	if !ok {
+		return ErrNotFound
+	}
+	deletedAt := trashed.DeletedAt.Time
+	revive(r.m.projects, r.m.trash.projects, func(p db.Project) bool { return p.ID == project.ID }, deletedAt, projectDeletion)
+	revive(r.m.tickets, r.m.trash.tickets, func(t db.Ticket) bool { return t.ProjectID == project.ID }, deletedAt, ticketDeletion)
+	revive(r.m.tasks, r.m.trash.tasks, func(t db.Task) bool { return isID(t.ProjectID, project.ID) }, deletedAt, taskDeletion)
+	revive(r.m.comments, r.m.trash.comments, r.m.projectComment(project.ID), deletedAt, commentDeletion)
+	reviveLinks(&r.m.projectTeams, &r.m.trash.projectTeams, func(l db.ProjectTeam) bool { return l.ProjectID == project.ID }, deletedAt, projectTeamDeletion)
+	reviveLinks(&r.m.projectEmployees, &r.m.trash.projectEmployees, func(l db.ProjectEmployee) bool { return l.ProjectID == project.ID }, deletedAt, projectEmployeeDeletion)
+	project.DeletedAt = gorm.DeletedAt{}
+	return nil
+}
+
+func (r memProjects) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	expireLinks(&r.m.trash.projectTeams, cutoff, projectTeamDeletion)
+	expireLinks(&r.m.trash.projectEmployees, cutoff, projectEmployeeDeletion)
+
+	ids := expire(r.m.trash.projects, cutoff, projectDeletion)
+	drop(func(t db.Ticket) bool { return slices.Contains(ids, t.ProjectID) }, r.m.tickets, r.m.trash.tickets)
+	drop(func(t db.Task) bool { return t.ProjectID != nil && slices.Contains(ids, *t.ProjectID) }, r.m.trash.tasks)
+	dropLinks(func(l db.ProjectTeam) bool { return slices.Contains(ids, l.ProjectID) }, &r.m.projectTeams, &r.m.trash.projectTeams)
+	dropLinks(func(l db.ProjectEmployee) bool { return slices.Contains(ids, l.ProjectID) }, &r.m.projectEmployees, &r.m.trash.projectEmployees)
+	for _, id := range ids {
+		delete(r.m.workflows, id)
+	}
+	r.m.dropOrphanComments()
+	return int64(len(ids)), nil
+}
+
+func (r memProjects) ListTeams(ctx context.Context, projectID int) ([]db.ProjectTeam, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	var links []db.ProjectTeam
+	for _, link := range r.m.projectTeams {
+		if link.ProjectID == projectID {
+			links = append(links, link)
+		}
+	}
+	return links, nil
+}
+
+func (r memProjects) AddTeam(ctx context.Context, link *db.ProjectTeam) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	for _, existing := range r.m.projectTeams {
+		if existing.ProjectID == link.ProjectID && existing.TeamID == link.TeamID {
+			return db.Conflict("team %d is already in project %d", link.TeamID, link.ProjectID)
+		}
+	}
+	r.m.trash.projectTeams = slices.DeleteFunc(r.m.trash.projectTeams, func(existing db.ProjectTeam) bool {
+		return existing.ProjectID == link.ProjectID && existing.TeamID == link.TeamID
+	})
+	link.CreatedAt = time.Now()
+	r.m.projectTeams = append(r.m.projectTeams, *link)
+	return nil
+}
+
+func (r memProjects) RemoveTeam(ctx context.Context, projectID int, teamID int) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	discardLinks(&r.m.projectTeams, &r.m.trash.projectTeams, func(link db.ProjectTeam) bool {
+		return link.ProjectID == projectID && link.TeamID == teamID
+	}, time.Now(), projectTeamDeletion)
+	return nil
+}
+
+func (r memProjects) ListEmployees(ctx context.Context, projectID int) ([]db.ProjectEmployee, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	var links []db.ProjectEmployee
+	for _, link := range r.m.projectEmployees {
+		if link.ProjectID == projectID {
+			links = append(links, link)
+		}
+	}
+	return links, nil
+}
+
+func (r memProjects) GetEmployee(ctx context.Context, projectID int, employeeID int) (*db.ProjectEmployee, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	for _, link := range r.m.projectEmployees {
+		if link.ProjectID == projectID && link.EmployeeID == employeeID {
+			return &link, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (r memProjects) AddEmployee(ctx context.Context, link *db.ProjectEmployee) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	for _, existing := range r.m.projectEmployees {
+		if existing.ProjectID == link.ProjectID && existing.EmployeeID == link.EmployeeID {
+			return db.Conflict("employee %d is already in project %d", link.EmployeeID, link.ProjectID)
+		}
+	}
+	if link.Role == "" {
+		link.Role = "MEMBER"
+	}
+	r.m.trash.projectEmployees = slices.DeleteFunc(r.m.trash.projectEmployees, func(existing db.ProjectEmployee) bool {
+		return existing.ProjectID == link.ProjectID && existing.EmployeeID == link.EmployeeID
+	})
+	link.CreatedAt = time.Now()
+	r.m.projectEmployees = append(r.m.projectEmployees, *link)
+	return nil
+}
+
+func (r memProjects) SaveEmployee(ctx context.Context, link *db.ProjectEmployee) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	for i, existing := range r.m.projectEmployees {
+		if existing.ProjectID == link.ProjectID && existing.EmployeeID == link.EmployeeID {
+			r.m.projectEmployees[i] = *link
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+func (r memProjects) RemoveEmployee(ctx context.Context, projectID int, employeeID int) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	discardLinks(&r.m.projectEmployees, &r.m.trash.projectEmployees, func(link db.ProjectEmployee) bool {
+		return link.ProjectID == projectID && link.EmployeeID == employeeID
+	}, time.Now(), projectEmployeeDeletion)
+	return nil
+}
+
+type memTeams struct {
+	m *Memory
+}
+
+func (r memTeams) List(ctx context.Context, filter *model.TeamFilter, page db.PageArgs) (*db.Page[db.Team], error) {
+	r.m.mu.Lock()
+	rows := values(r.m.teams, func(t db.Team) bool {
+		if filter == nil {
+			return true
+		}
+		return contains(t.Name, filter.Name) &&
+			equalsID(t.TeamLeaderID, filter.TeamLeaderID) &&
+			between(t.CreatedAt, filter.CreatedAtAfter, filter.CreatedAtBefore)
+	})
+	r.m.mu.Unlock()
+	return db.PaginateSlice(rows, db.TeamSorts, page)
+}
+
+func (r memTeams) Get(ctx context.Context, id int) (*db.Team, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	return get(r.m.teams, id)
+}
+
+func (r memTeams) ListByProjects(ctx context.Context, projectIDs []int) (map[int][]db.Team, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	result := make(map[int][]db.Team)
+	for _, team := range values(r.m.teams, func(db.Team) bool { return true }) {
+		for _, link := range r.m.projectTeams {
+			if link.TeamID == team.ID && slices.Contains(projectIDs, link.ProjectID) {
+				result[link.ProjectID] = append(result[link.ProjectID], team)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (r memTeams) Create(ctx context.Context, team *db.Team) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	now := time.Now()
+	team.ID = r.m.id()
+	team.CreatedAt, team.UpdatedAt = now, now
+	r.m.teams[team.ID] = *team
+	return nil
+}
+
+func (r memTeams) Save(ctx context.Context, team *db.Team) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	if _, ok := r.m.teams[team.ID]; !ok {
+		return ErrNotFound
+	}
+	team.UpdatedAt = time.Now()
+	r.m.teams[team.ID] = *team
+	return nil
+}
+
+func (r memTeams) Delete(ctx context.Context, team *db.Team) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	now := time.Now()
+	if !discard(r.m.teams, r.m.trash.teams, func(t db.Team) bool { return t.ID == team.ID }, now, teamDeletion) {
+		return ErrNotFound
+	}
+	discardLinks(&r.m.teamEngineers, &r.m.trash.teamEngineers, func(l db.TeamEngineer) bool { return l.TeamID == team.ID }, now, teamEngineerDeletion)
+	discardLinks(&r.m.projectTeams, &r.m.trash.projectTeams, func(l db.ProjectTeam) bool { return l.TeamID == team.ID }, now, projectTeamDeletion)
+	team.DeletedAt = gorm.DeletedAt{Time: now, Valid: true}
+	return nil
+}
+
+func (r memTeams) ListTrashed(ctx context.Context, page db.PageArgs) (*db.Page[db.Team], error) {
+	r.m.mu.Lock()
+	rows := values(r.m.trash.teams, func(db.Team) bool { return true })
+	r.m.mu.Unlock()
+	return db.PaginateSlice(rows, db.TeamSorts, page)
+}
+
+func (r memTeams) GetTrashed(ctx context.Context, id int) (*db.Team, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	return get(r.m.trash.teams, id)
+}
+
+func (r memTeams) Restore(ctx context.Context, team *db.Team) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	trashed, ok := r.m.trash.teams[team.ID]
+	if !ok {
+		return ErrNotFound
+	}
+	deletedAt := trashed.DeletedAt.Time
+	revive(r.m.teams, r.m.trash.teams, func(t db.Team) bool { return t.ID == team.ID }, deletedAt, teamDeletion)
+	reviveLinks(&r.m.teamEngineers, &r.m.trash.teamEngineers, func(l db.TeamEngineer) bool { return l.TeamID == team.ID }, deletedAt, teamEngineerDeletion)
+	reviveLinks(&r.m.projectTeams, &r.m.trash.projectTeams, func(l db.ProjectTeam) bool { return l.TeamID == team.ID }, deletedAt, projectTeamDeletion)
+	team.DeletedAt = gorm.DeletedAt{}
+	return nil
+}
+
+func (r memTeams) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	expireLinks(&r.m.trash.teamEngineers, cutoff, teamEngineerDeletion)
+
+	ids := expire(r.m.trash.teams, cutoff, teamDeletion)
+	dropLinks(func(l db.TeamEngineer) bool { return slices.Contains(ids, l.TeamID) }, &r.m.teamEngineers, &r.m.trash.teamEngineers)
+	dropLinks(func(l db.ProjectTeam) bool { return slices.Contains(ids, l.TeamID) }, &r.m.projectTeams, &r.m.trash.projectTeams)
+	return int64(len(ids)), nil
+}
+
+func (r memTeams) ListEngineers(ctx context.Context, teamID int) ([]db.TeamEngineer, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	var links []db.TeamEngineer
+	for _, link := range r.m.teamEngineers {
+		if link.TeamID == teamID {
+			links = append(links, link)
+		}
+	}
+	return links, nil
+}
+
+func (r memTeams) AddEngineer(ctx context.Context, link *db.TeamEngineer) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	for _, existing := range r.m.teamEngineers {
+		if existing.TeamID == link.TeamID && existing.EngineerID == link.EngineerID {
+			return db.Conflict("engineer %d is already in team %d", link.EngineerID, link.TeamID)
+		}
+	}
+	r.m.trash.teamEngineers = slices.DeleteFunc(r.m.trash.teamEngineers, func(existing db.TeamEngineer) bool {
+		return existing.TeamID == link.TeamID && existing.EngineerID == link.EngineerID
+	})
+	link.CreatedAt = time.Now()
+	r.m.teamEngineers = append(r.m.teamEngineers, *link)
+	return nil
+}
+
+func (r memTeams) RemoveEngineer(ctx context.Context, teamID int, engineerID int) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	discardLinks(&r.m.teamEngineers, &r.m.trash.teamEngineers, func(link db.TeamEngineer) bool {
+		return link.TeamID == teamID && link.EngineerID == engineerID
+	}, time.Now(), teamEngineerDeletion)
+	return nil
+}
+
+type memTickets struct {
+	m      *Memory
+	policy *policy.Engine
+}
+
+// visible returns the tickets matching keep that viewer may see, in id order
+func (r memTickets) visible(ctx context.Context, viewer *model.AuthUser, keep func(db.Ticket) bool) ([]db.Ticket, error) {
+	r.m.mu.Lock()
+	rows := values(r.m.tickets, keep)
+	r.m.mu.Unlock()
+	return r.allowed(ctx, viewer, rows)
+}
+
+// allowed keeps the rows viewer may see. The store lock must not be held because the
+// policy engine reads the store as its Directory.
+func (r memTickets) allowed(ctx context.Context, viewer *model.AuthUser, rows []db.Ticket) ([]db.Ticket, error) {
+	var visible []db.Ticket
+	for _, ticket := range rows {
+		projectID := ticket.ProjectID
+		ok, err := r.policy.CanViewWork(ctx, viewer, ticket.AssignedToID, &projectID)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			visible = append(visible, ticket)
+		}
+	}
+	return visible, nil
+}
+
+func (r memTickets) List(ctx context.Context, viewer *model.AuthUser, filter *model.TicketFilter, page db.PageArgs) (*db.Page[db.Ticket], error) {
+	rows, err := r.visible(ctx, viewer, func(t db.Ticket) bool {
+		if filter == nil {
+			return true
+		}
+		return contains(t.Title, filter.Title) &&
+			(filter.Status == nil || t.Status == db.StatusToDB(*filter.Status)) &&
+			(filter.Priority == nil || t.Priority == db.PriorityToDB(*filter.Priority)) &&
+			equals(t.ProjectID, filter.ProjectID) &&
+			equalsID(t.AssignedToID, filter.AssignedToID) &&
+			between(t.CreatedAt, filter.CreatedAtAfter, filter.CreatedAtBefore)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return db.PaginateSlice(rows, db.TicketSorts, page)
+}
+
+func (r memTickets) GetVisible(ctx context.Context, viewer *model.AuthUser, id int) (*db.Ticket, error) {
+	rows, err := r.visible(ctx, viewer, func(t db.Ticket) bool { return t.ID == id })
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ErrNotFound
+	}
+	return &rows[0], nil
+}
+
+func (r memTickets) ListByProjects(ctx context.Context, viewer *model.AuthUser, projectIDs []int) (map[int][]db.Ticket, error) {
+	rows, err := r.visible(ctx, viewer, func(t db.Ticket) bool { return slices.Contains(projectIDs, t.ProjectID) })
+	if err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(rows, func(a, b db.Ticket) int { return a.CreatedAt.Compare(b.CreatedAt) })
+
+	result := make(map[int][]db.Ticket)
+	for _, ticket := range rows {
+		result[ticket.ProjectID] = append(result[ticket.ProjectID], ticket)
+	}
+	return result, nil
+}
+
+func (r memTickets) Get(ctx context.Context, id int) (*db.Ticket, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	return get(r.m.tickets, id)
+}
+
+func (r memTickets) Create(ctx context.Context, ticket *db.Ticket) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	if ticket.Priority == "" {
+		ticket.Priority = string(model.PriorityMedium)
+	}
+	ticket.ID = r.m.id()
+	ticket.CreatedAt = time.Now()
+	r.m.tickets[ticket.ID] = *ticket
+	return nil
+}
+
+func (r memTickets) Save(ctx context.Context, ticket *db.Ticket) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	if _, ok := r.m.tickets[ticket.ID]; !ok {
+		return ErrNotFound
+	}
+	r.m.tickets[ticket.ID] = *ticket
+	return nil
+}
+
+func (r memTickets) Delete(ctx context.Context, ticket *db.Ticket) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	now := time.Now()
+	if !discard(r.m.tickets, r.m.trash.tickets, func(t db.Ticket) bool { return t.ID == ticket.ID }, now, ticketDeletion) {
+		return ErrNotFound
+	}
+	discard(r.m.comments, r.m.trash.comments, func(c db.Comment) bool { return isID(c.TicketID, ticket.ID) }, now, commentDeletion)
+	ticket.DeletedAt = gorm.DeletedAt{Time: now, Valid: true}
+	return nil
+}
+
+func (r memTickets) ListTrashed(ctx context.Context, viewer *model.AuthUser, page db.PageArgs) (*db.Page[db.Ticket], error) {
+	r.m.mu.Lock()
+	rows := values(r.m.trash.tickets, func(db.Ticket) bool { return true })
+	r.m.mu.Unlock()
+
+	rows, err := r.allowed(ctx, viewer, rows)
+	if err != nil {
+		return nil, err
+	}
+	return db.PaginateSlice(rows, db.TicketSorts, page)
+}
+
+func (r memTickets) GetTrashed(ctx context.Context, id int) (*db.Ticket, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	return get(r.m.trash.tickets, id)
+}
+
+func (r memTickets) Restore(ctx context.Context, ticket *db.Ticket) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	trashed, ok := r.m.trash.tickets[ticket.ID]
+	if !ok {
+		return ErrNotFound
+	}
+	deletedAt := trashed.DeletedAt.Time
+	revive(r.m.tickets, r.m.trash.tickets, func(t db.Ticket) bool { return t.ID == ticket.ID }, deletedAt, ticketDeletion)
+	revive(r.m.comments, r.m.trash.comments, func(c db.Comment) bool { return isID(c.TicketID, ticket.ID) }, deletedAt, commentDeletion)
+	ticket.DeletedAt = gorm.DeletedAt{}
+	return nil
+}
+
+func (r memTickets) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	purged := expire(r.m.trash.tickets, cutoff, ticketDeletion)
+	r.m.dropOrphanComments()
+	return int64(len(purged)), nil
+}
+
+type memTasks struct {
+	m      *Memory
+	policy *policy.Engine
+}
+
+// visible works like memTickets.visible
+func (r memTasks) visible(ctx context.Context, viewer *model.AuthUser, keep func(db.Task) bool) ([]db.Task, error) {
+	r.m.mu.Lock()
+	rows := values(r.m.tasks, keep)
+	r.m.mu.Unlock()
+	return r.allowed(ctx, viewer, rows)
+}
+
+func (r memTasks) allowed(ctx context.Context, viewer *model.AuthUser, rows []db.Task) ([]db.Task, error) {
+	var visible []db.Task
+	for _, task := range rows {
+		ok, err := r.policy.CanViewWork(ctx, viewer, task.AssignedToID, task.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			visible = append(visible, task)
+		}
+	}
+	return visible, nil
+}
+
+func (r memTasks) List(ctx context.Context, viewer *model.AuthUser, filter *model.TaskFilter, page db.PageArgs) (*db.Page[db.Task], error) {
+	var due [2]*time.Time
+	if filter != nil {
+		var err error
+		if due, err = dateRange(filter.DueDateAfter, filter.DueDateBefore); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := r.visible(ctx, viewer, func(t db.Task) bool {
+		if filter == nil {
+			return true
+		}
+		return contains(t.Title, filter.Title) &&
+			(filter.Status == nil || t.Status == db.StatusToDB(*filter.Status)) &&
+			(filter.Priority == nil || t.Priority == db.PriorityToDB(*filter.Priority)) &&
+			equalsID(t.AssignedToID, filter.AssignedToID) &&
+			equalsID(t.ProjectID, filter.ProjectID) &&
+			dateBetween(t.DueDate, due) &&
+			between(t.CreatedAt, filter.CreatedAtAfter, filter.CreatedAtBefore)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return db.PaginateSlice(rows, db.TaskSorts, page)
+}
+
+func (r memTasks) GetVisible(ctx context.Context, viewer *model.AuthUser, id int) (*db.Task, error) {
+	rows, err := r.visible(ctx, viewer, func(t db.Task) bool { return t.ID == id })
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ErrNotFound
+	}
+	return &rows[0], nil
+}
+
+func (r memTasks) Get(ctx context.Context, id int) (*db.Task, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	return get(r.m.tasks, id)
+}
+
+func (r memTasks) Create(ctx context.Context, task *db.Task) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	if task.Priority == "" {
+		task.Priority = string(model.PriorityMedium)
+	}
+	task.ID = r.m.id()
+	task.CreatedAt = time.Now()
+	r.m.tasks[task.ID] = *task
+	return nil
+}
+
+func (r memTasks) Save(ctx context.Context, task *db.Task) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	if _, ok := r.m.tasks[task.ID]; !ok {
+		return ErrNotFound
+	}
+	r.m.tasks[task.ID] = *task
+	return nil
+}
+
+func (r memTasks) Delete(ctx context.Context, task *db.Task) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	now := time.Now()
+	if !discard(r.m.tasks, r.m.trash.tasks, func(t db.Task) bool { return t.ID == task.ID }, now, taskDeletion) {
+		return ErrNotFound
+	}
+	discard(r.m.comments, r.m.trash.comments, func(c db.Comment) bool { return isID(c.TaskID, task.ID) }, now, commentDeletion)
+	task.DeletedAt = gorm.DeletedAt{Time: now, Valid: true}
+	return nil
+}
+
+func (r memTasks) ListTrashed(ctx context.Context, viewer *model.AuthUser, page db.PageArgs) (*db.Page[db.Task], error) {
+	r.m.mu.Lock()
+	rows := values(r.m.trash.tasks, func(db.Task) bool { return true })
+	r.m.mu.Unlock()
+
+	rows, err := r.allowed(ctx, viewer, rows)
+	if err != nil {
+		return nil, err
+	}
+	return db.PaginateSlice(rows, db.TaskSorts, page)
+}
+
+func (r memTasks) GetTrashed(ctx context.Context, id int) (*db.Task, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	return get(r.m.trash.tasks, id)
+}
+
+func (r memTasks) Restore(ctx context.Context, task *db.Task) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	trashed, ok := r.m.trash.tasks[task.ID]
+	if !ok {
+		return ErrNotFound
+	}
+	deletedAt := trashed.DeletedAt.Time
+	revive(r.m.tasks, r.m.trash.tasks, func(t db.Task) bool { return t.ID == task.ID }, deletedAt, taskDeletion)
+	revive(r.m.comments, r.m.trash.comments, func(c db.Comment) bool { return isID(c.TaskID, task.ID) }, deletedAt, commentDeletion)
+	task.DeletedAt = gorm.DeletedAt{}
+	return nil
+}
+
+func (r memTasks) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	purged := expire(r.m.trash.tasks, cutoff, taskDeletion)
+	r.m.dropOrphanComments()
+	return int64(len(purged)), nil
+}
+
+type memNotifications struct {
+	m *Memory
+}
+
+func (r memNotifications) List(ctx context.Context, employeeID int, filter *model.NotificationFilter, page db.PageArgs) (*db.Page[db.Notification], error) {
+	r.m.mu.Lock()
+	rows := values(r.m.notifications, func(n db.Notification) bool {
+		if n.EmployeeID != employeeID {
+			return false
+		}
+		if filter == nil {
+			return true
+		}
+		return (filter.Type == nil || n.Type == db.NotificationTypeToDB(*filter.Type)) &&
+			equals(n.Read, filter.Read) &&
+			between(n.CreatedAt, filter.CreatedAtAfter, filter.CreatedAtBefore)
+	})
+	r.m.mu.Unlock()
+	return db.PaginateSlice(rows, db.NotificationSorts, page)
+}
+
+func (r memNotifications) Get(ctx context.Context, id int) (*db.Notification, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	return get(r.m.notifications, id)
+}
+
+func (r memNotifications) Create(ctx context.Context, notification *db.Notification) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	if notification.Type == "" {
+		notification.Type = string(model.NotificationTypeInfo)
+	}
+	notification.ID = r.m.id()
+	notification.CreatedAt = time.Now()
+	r.m.notifications[notification.ID] = *notification
+	return nil
+}
+
+func (r memNotifications) Save(ctx context.Context, notification *db.Notification) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	if _, ok := r.m.notifications[notification.ID]; !ok {
+		return ErrNotFound
+	}
+	r.m.notifications[notification.ID] = *notification
+	return nil
+}
+
+type memWorkflows struct {
+	m *Memory
+}
+
+func (r memWorkflows) Get(ctx context.Context, projectID int) (*db.Workflow, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	return get(r.m.workflows, projectID)
+}
+
+func (r memWorkflows) Save(ctx context.Context, workflow *db.Workflow) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	if _, ok := r.m.projects[workflow.ProjectID]; !ok {
+		return db.InvalidInput("a referenced record does not exist")
+	}
+	now := time.Now()
+	if existing, ok := r.m.workflows[workflow.ProjectID]; ok {
+		workflow.CreatedAt = existing.CreatedAt
+	} else {
+		workflow.CreatedAt = now
+	}
+	workflow.UpdatedAt = now
+	r.m.workflows[workflow.ProjectID] = *workflow
+	return nil
+}
+
+func (r memWorkflows) Delete(ctx context.Context, projectID int) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	delete(r.m.workflows, projectID)
+	return nil
+}
+
+type memAudit struct {
+	m *Memory
+}
+
+func (r memAudit) List(ctx context.Context, filter *model.AuditLogFilter, page db.PageArgs) (*db.Page[db.AuditEntry], error) {
+	r.m.mu.Lock()
+	rows := values(r.m.auditEntries, func(e db.AuditEntry) bool {
+		if filter == nil {
+			return true
+		}
+		return equalsID(e.ActorID, filter.ActorID) &&
+			equals(e.EntityType, filter.EntityType) &&
+			equals(e.EntityID, filter.EntityID) &&
+			(filter.Operation == nil || e.Operation == db.AuditOperationToDB(*filter.Operation)) &&
+			equals(e.RequestID, filter.RequestID) &&
+			between(e.CreatedAt, filter.CreatedAtAfter, filter.CreatedAtBefore)
+	})
+	r.m.mu.Unlock()
+	return db.PaginateSlice(rows, db.AuditEntrySorts, page)
+}
+
+func (r memAudit) ListByEntities(ctx context.Context, entityType string, ids []int) (map[int][]db.AuditEntry, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	result := make(map[int][]db.AuditEntry)
+	for _, entry := range values(r.m.auditEntries, func(e db.AuditEntry) bool { return e.EntityType == entityType }) {
+		id, err := strconv.Atoi(entry.EntityID)
+		if err == nil && slices.Contains(ids, id) {
+			result[id] = append(result[id], entry)
+		}
+	}
+	return result, nil
+}
+
+func (r memAudit) Create(ctx context.Context, entry *db.AuditEntry) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	entry.ID = r.m.id()
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+	r.m.auditEntries[entry.ID] = *entry
+	return nil
+}
+
+type memComments struct {
+	m *Memory
+}
+
+func (r memComments) ListByTicket(ctx context.Context, ticketID int, page db.PageArgs) (*db.Page[db.Comment], error) {
+	r.m.mu.Lock()
+	rows := values(r.m.comments, func(c db.Comment) bool { return isID(c.TicketID, ticketID) && c.ParentID == nil })
+	r.m.mu.Unlock()
+	return db.PaginateSlice(rows, db.CommentSorts, page)
+}
+
+func (r memComments) ListByTask(ctx context.Context, taskID int, page db.PageArgs) (*db.Page[db.Comment], error) {
+	r.m.mu.Lock()
+	rows := values(r.m.comments, func(c db.Comment) bool { return isID(c.TaskID, taskID) && c.ParentID == nil })
+	r.m.mu.Unlock()
+	return db.PaginateSlice(rows, db.CommentSorts, page)
+}
+
+func (r memComments) ListReplies(ctx context.Context, parentIDs []int) (map[int][]db.Comment, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	result := make(map[int][]db.Comment)
+	for _, reply := range values(r.m.comments, func(c db.Comment) bool { return c.ParentID != nil && slices.Contains(parentIDs, *c.ParentID) }) {
+		result[*reply.ParentID] = append(result[*reply.ParentID], reply)
+	}
+	return result, nil
+}
+
+func (r memComments) ListRevisions(ctx context.Context, commentIDs []int) (map[int][]db.CommentRevision, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	result := make(map[int][]db.CommentRevision)
+	for _, revision := range values(r.m.commentRevisions, func(c db.CommentRevision) bool { return slices.Contains(commentIDs, c.CommentID) }) {
+		result[revision.CommentID] = append(result[revision.CommentID], revision)
+	}
+	return result, nil
+}
+
+func (r memComments) Get(ctx context.Context, id int) (*db.Comment, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	return get(r.m.comments, id)
+}
+
+func (r memComments) Create(ctx context.Context, comment *db.Comment) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	if !present(comment.TicketID, r.m.tickets) || !present(comment.TaskID, r.m.tasks) || !present(comment.ParentID, r.m.comments) {
+		return db.InvalidInput("a referenced record does not exist")
+	}
+	comment.ID = r.m.id()
+	comment.CreatedAt = time.Now()
+	comment.UpdatedAt = comment.CreatedAt
+	r.m.comments[comment.ID] = *comment
+	return nil
+}
+
+func (r memComments) Edit(ctx context.Context, comment *db.Comment, body string) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	current, ok := r.m.comments[comment.ID]
+	if !ok {
+		return ErrNotFound
+	}
+
+	now := time.Now()
+	revision := db.CommentRevision{ID: r.m.id(), CommentID: comment.ID, Body: current.Body, CreatedAt: now}
+	r.m.commentRevisions[revision.ID] = revision
+	comment.Body = body
+	comment.EditedAt = &now
+	comment.UpdatedAt = now
+	r.m.comments[comment.ID] = *comment
+	return nil
+}
+
+func (r memComments) Delete(ctx context.Context, comment *db.Comment) error {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	now := time.Now()
+	if !discard(r.m.comments, r.m.trash.comments, func(c db.Comment) bool { return c.ID == comment.ID }, now, commentDeletion) {
+		return ErrNotFound
+	}
+	// Each pass takes the next level of replies down the thread
+	for thread := []int{comment.ID}; len(thread) > 0; {
+		parents := thread
+		thread = nil
+		discard(r.m.comments, r.m.trash.comments, func(c db.Comment) bool {
+			if c.ParentID == nil || !slices.Contains(parents, *c.ParentID) {
+				return false
+			}
+			thread = append(thread, c.ID)
+			return true
+		}, now, commentDeletion)
+	}
+	comment.DeletedAt = gorm.DeletedAt{Time: now, Valid: true}
+	return nil
+}
+
+func (r memComments) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	purged := expire(r.m.trash.comments, cutoff, commentDeletion)
+	r.m.dropOrphanComments()
+	return int64(len(purged)), nil
+}
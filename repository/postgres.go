@@ -0,0 +1,751 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/auth"
+	"github.com/JonJenson-MFIn/project-management-system-api/cache"
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/JonJenson-MFIn/project-management-system-api/policy"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NewPostgres creates repositories backed by database. Ticket and task visibility is
+// pushed into SQL through the policy engine's scopes.
+func NewPostgres(database *gorm.DB, engine *policy.Engine) *Repositories {
+	return &Repositories{
+		Employees:     &pgEmployees{database},
+		Projects:      &pgProjects{database},
+		Teams:         &pgTeams{database},
+		Tickets:       &pgTickets{database, engine},
+		Tasks:         &pgTasks{database, engine},
+		Notifications: &pgNotifications{database},
+		Workflows:     &pgWorkflows{database},
+		Audit:         &pgAudit{database},
+		Comments:      &pgComments{database},
+	}
+}
+
+// first loads one row, translating GORM's not-found error into ErrNotFound
+func first[T any](query *gorm.DB, conditions ...interface{}) (*T, error) {
+	var row T
+	if err := query.First(&row, conditions...).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &row, nil
+}
+
+// translate turns constraint violations into errors the client can act on: a conflict for
+// a unique key and invalid input for a dangling reference. It relies on TranslateError
+// being enabled on the connection.
+func translate(err error, duplicate string, args ...any) error {
+	switch {
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return db.Conflict(duplicate, args...)
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
+		return db.InvalidInput("a referenced record does not exist")
+	}
+	return err
+}
+
+// linked resolves the rows a junction table points at in one query and groups them by
+// the owning key, in id order
+func linked[L any, T any](ctx context.Context, database *gorm.DB, links []L, link func(L) (int, int), id func(T) int) (map[int][]T, error) {
+	result := make(map[int][]T)
+	if len(links) == 0 {
+		return result, nil
+	}
+
+	owners := make(map[int][]int)
+	targetIDs := make([]int, 0, len(links))
+	for _, l := range links {
+		owner, target := link(l)
+		owners[target] = append(owners[target], owner)
+		targetIDs = append(targetIDs, target)
+	}
+
+	var rows []T
+	if err := database.WithContext(ctx).Where("id IN ?", targetIDs).Order("id").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		for _, owner := range owners[id(row)] {
+			result[owner] = append(result[owner], row)
+		}
+	}
+	return result, nil
+}
+
+// dependent is a table whose rows are soft-deleted and restored along with the row whose
+// id condition is given
+type dependent struct {
+	model     interface{}
+	condition string
+}
+
+var (
+	employeeDependents = []dependent{{&db.TeamEngineer{}, "engineer_id = ?"}, {&db.ProjectEmployee{}, "employee_id = ?"}}
+	projectDependents  = []dependent{
+		{&db.Ticket{}, "project_id = ?"},
+		{&db.Task{}, "project_id = ?"},
+		{&db.Comment{}, "ticket_id IN (SELECT id FROM tickets WHERE project_id = ?)"},
+		{&db.Comment{}, "task_id IN (SELECT id FROM tasks WHERE project_id = ?)"},
+		{&db.ProjectTeam{}, "project_id = ?"},
+		{&db.ProjectEmployee{}, "project_id = ?"},
+	}
+	teamDependents   = []dependent{{&db.TeamEngineer{}, "team_id = ?"}, {&db.ProjectTeam{}, "team_id = ?"}}
+	ticketDependents = []dependent{{&db.Comment{}, "ticket_id = ?"}}
+	taskDependents   = []dependent{{&db.Comment{}, "task_id = ?"}}
+)
+
+// Tables whose foreign keys cascade or set null when a row is purged
+var (
+	employeeCascades = []string{"sessions", "notifications", "team_engineers", "project_employees", "teams", "projects", "tickets", "tasks", "comments"}
+	projectCascades  = []string{"tickets", "project_teams", "project_employees", "workflows", "employees", "tasks", "comments", "comment_revisions"}
+	teamCascades     = []string{"team_engineers", "project_teams"}
+	commentCascades  = []string{"comments", "comment_revisions"}
+)
+
+// softDelete deletes row and the live rows depending on it with one timestamp, which is
+// how restore later tells them from rows that were deleted on their own
+func softDelete(tx *gorm.DB, row interface{}, id int, dependents []dependent) error {
+	now := time.Now()
+	tx = tx.Session(&gorm.Session{NowFunc: func() time.Time { return now }})
+	for _, d := range dependents {
+		if err := tx.Where(d.condition, id).Delete(d.model).Error; err != nil {
+			return err
+		}
+	}
+	return tx.Delete(row).Error
+}
+
+// restore undeletes row and the dependents deleted at the same moment
+func restore(tx *gorm.DB, row interface{}, id int, deletedAt gorm.DeletedAt, dependents []dependent) error {
+	for _, d := range dependents {
+		err := tx.Unscoped().Model(d.model).Where(d.condition, id).Where("deleted_at = ?", deletedAt.Time).Update("deleted_at", nil).Error
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Unscoped().Model(row).Update("deleted_at", nil).Error
+}
+
+// trashed scopes a query to soft-deleted rows
+func trashed(query *gorm.DB, table string) *gorm.DB {
+	return query.Unscoped().Where(table + ".deleted_at IS NOT NULL")
+}
+
+// purge permanently removes the rows of model deleted before cutoff. The foreign keys
+// delete or detach the rows of cascades along with them behind GORM's back, so their
+// cached responses are dropped here.
+func purge(tx *gorm.DB, model interface{}, cutoff time.Time, cascades ...string) (int64, error) {
+	result := tx.Unscoped().Where("deleted_at < ?", cutoff).Delete(model)
+	if result.Error == nil && result.RowsAffected > 0 && len(cascades) > 0 {
+		cache.Invalidate(tx, cascades...)
+	}
+	return result.RowsAffected, result.Error
+}
+
+// addLink inserts a junction row, reviving a soft-deleted copy of it with the new values
+// of columns. A live copy is a duplicate key.
+func addLink(ctx context.Context, database *gorm.DB, link interface{}, keys []string, columns ...string) error {
+	conflict := clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns(append([]string{"created_at", "deleted_at"}, columns...)),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: "? IS NOT NULL", Vars: []interface{}{clause.Column{Table: clause.CurrentTable, Name: "deleted_at"}}},
+		}},
+	}
+	for _, key := range keys {
+		conflict.Columns = append(conflict.Columns, clause.Column{Name: key})
+	}
+
+	result := database.WithContext(ctx).Clauses(conflict).Create(link)
+	if result.Error == nil && result.RowsAffected == 0 {
+		return gorm.ErrDuplicatedKey
+	}
+	return result.Error
+}
+
+type pgEmployees struct {
+	db *gorm.DB
+}
+
+func (r *pgEmployees) List(ctx context.Context, filter *model.EmployeeFilter, page db.PageArgs) (*db.Page[db.Employee], error) {
+	query := r.db.WithContext(ctx).Model(&db.Employee{}).Scopes(db.EmployeeFilterScope(filter))
+	return db.Paginate(query, db.EmployeeSorts, page)
+}
+
+func (r *pgEmployees) Get(ctx context.Context, id int) (*db.Employee, error) {
+	return first[db.Employee](r.db.WithContext(ctx), id)
+}
+
+func (r *pgEmployees) GetByEmail(ctx context.Context, email string) (*db.Employee, error) {
+	return first[db.Employee](r.db.WithContext(ctx).Where("email = ?", email))
+}
+
+func (r *pgEmployees) GetMany(ctx context.Context, ids []int) (map[int]*db.Employee, error) {
+	var employees []db.Employee
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&employees).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[int]*db.Employee, len(employees))
+	for i := range employees {
+		result[employees[i].ID] = &employees[i]
+	}
+	return result, nil
+}
+
+func (r *pgEmployees) ListByProject(ctx context.Context, projectID int) ([]db.Employee, error) {
+	var employees []db.Employee
+	err := r.db.WithContext(ctx).Where("project_assigned_id = ?", projectID).Find(&employees).Error
+	return employees, err
+}
+
+func (r *pgEmployees) ListByTeams(ctx context.Context, teamIDs []int) (map[int][]db.Employee, error) {
+	var links []db.TeamEngineer
+	if err := r.db.WithContext(ctx).Where("team_id IN ?", teamIDs).Find(&links).Error; err != nil {
+		return nil, err
+	}
+	return linked(ctx, r.db, links,
+		func(link db.TeamEngineer) (int, int) { return link.TeamID, link.EngineerID },
+		func(employee db.Employee) int { return employee.ID })
+}
+
+func (r *pgEmployees) Create(ctx context.Context, employee *db.Employee) error {
+	err := r.db.WithContext(ctx).Create(employee).Error
+	return translate(err, "employee with email %q already exists", employee.Email)
+}
+
+func (r *pgEmployees) Save(ctx context.Context, employee *db.Employee) error {
+	return cache.Transaction(ctx, r.db, func(tx *gorm.DB) error {
+		if err := tx.Save(employee).Error; err != nil {
+			return translate(err, "employee with email %q already exists", employee.Email)
+		}
+
+		// A deactivated employee must not keep any working session
+		if !employee.Active {
+			if _, err := auth.RevokeEmployeeSessions(tx, employee.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *pgEmployees) Delete(ctx context.Context, employee *db.Employee) error {
+	return cache.Transaction(ctx, r.db, func(tx *gorm.DB) error {
+		if err := softDelete(tx, employee, employee.ID, employeeDependents); err != nil {
+			return err
+		}
+
+		_, err := auth.RevokeEmployeeSessions(tx, employee.ID)
+		return err
+	})
+}
+
+func (r *pgEmployees) ListTrashed(ctx context.Context, page db.PageArgs) (*db.Page[db.Employee], error) {
+	return db.Paginate(trashed(r.db.WithContext(ctx).Model(&db.Employee{}), "employees"), db.EmployeeSorts, page)
+}
+
+func (r *pgEmployees) GetTrashed(ctx context.Context, id int) (*db.Employee, error) {
+	return first[db.Employee](trashed(r.db.WithContext(ctx), "employees"), id)
+}
+
+func (r *pgEmployees) Restore(ctx context.Context, employee *db.Employee) error {
+	return cache.Transaction(ctx, r.db, func(tx *gorm.DB) error {
+		return restore(tx, employee, employee.ID, employee.DeletedAt, employeeDependents)
+	})
+}
+
+// Purge leaves memberships to the ON DELETE CASCADE of their foreign keys
+func (r *pgEmployees) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	return purge(r.db.WithContext(ctx), &db.Employee{}, cutoff, employeeCascades...)
+}
+
+type pgProjects struct {
+	db *gorm.DB
+}
+
+func (r *pgProjects) List(ctx context.Context, filter *model.ProjectFilter, page db.PageArgs) (*db.Page[db.Project], error) {
+	query := r.db.WithContext(ctx).Model(&db.Project{}).Scopes(db.ProjectFilterScope(filter))
+	return db.Paginate(query, db.ProjectSorts, page)
+}
+
+func (r *pgProjects) Get(ctx context.Context, id int) (*db.Project, error) {
+	return first[db.Project](r.db.WithContext(ctx), id)
+}
+
+func (r *pgProjects) ListByEmployees(ctx context.Context, employeeIDs []int) (map[int][]db.Project, error) {
+	var links []db.ProjectEmployee
+	if err := r.db.WithContext(ctx).Where("employee_id IN ?", employeeIDs).Find(&links).Error; err != nil {
+		return nil, err
+	}
+	return linked(ctx, r.db, links,
+		func(link db.ProjectEmployee) (int, int) { return link.EmployeeID, link.ProjectID },
+		func(project db.Project) int { return project.ID })
+}
+
+func (r *pgProjects) Create(ctx context.Context, project *db.Project) error {
+	return r.db.WithContext(ctx).Create(project).Error
+}
+
+func (r *pgProjects) Save(ctx context.Context, project *db.Project) error {
+	return r.db.WithContext(ctx).Save(project).Error
+}
+
+func (r *pgProjects) Delete(ctx context.Context, project *db.Project) error {
+	return cache.Transaction(ctx, r.db, func(tx *gorm.DB) error {
+		return softDelete(tx, project, project.ID, projectDependents)
+	})
+}
+
+func (r *pgProjects) ListTrashed(ctx context.Context, page db.PageArgs) (*db.Page[db.Project], error) {
+	return db.Paginate(trashed(r.db.WithContext(ctx).Model(&db.Project{}), "projects"), db.ProjectSorts, page)
+}
+
+func (r *pgProjects) GetTrashed(ctx context.Context, id int) (*db.Project, error) {
+	return first[db.Project](trashed(r.db.WithContext(ctx), "projects"), id)
+}
+
+func (r *pgProjects) Restore(ctx context.Context, project *db.Project) error {
+	return cache.Transaction(ctx, r.db, func(tx *gorm.DB) error {
+		return restore(tx, project, project.ID, project.DeletedAt, projectDependents)
+	})
+}
+
+// Purge also removes the trashed tasks of the purged projects, which the foreign key
+// would only detach. Tickets and memberships go through ON DELETE CASCADE.
+func (r *pgProjects) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	var purged int64
+	err := cache.Transaction(ctx, r.db, func(tx *gorm.DB) error {
+		expired := tx.Unscoped().Model(&db.Project{}).Select("id").Where("deleted_at < ?", cutoff)
+		if err := tx.Unscoped().Where("deleted_at IS NOT NULL AND project_id IN (?)", expired).Delete(&db.Task{}).Error; err != nil {
+			return err
+		}
+		for _, link := range []interface{}{&db.ProjectTeam{}, &db.ProjectEmployee{}} {
+			if _, err := purge(tx, link, cutoff); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		purged, err = purge(tx, &db.Project{}, cutoff, projectCascades...)
+		return err
+	})
+	return purged, err
+}
+
+func (r *pgProjects) ListTeams(ctx context.Context, projectID int) ([]db.ProjectTeam, error) {
+	var links []db.ProjectTeam
+	err := r.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&links).Error
+	return links, err
+}
+
+func (r *pgProjects) AddTeam(ctx context.Context, link *db.ProjectTeam) error {
+	err := addLink(ctx, r.db, link, []string{"project_id", "team_id"})
+	return translate(err, "team %d is already in project %d", link.TeamID, link.ProjectID)
+}
+
+func (r *pgProjects) RemoveTeam(ctx context.Context, projectID int, teamID int) error {
+	return r.db.WithContext(ctx).Where("project_id = ? AND team_id = ?", projectID, teamID).Delete(&db.ProjectTeam{}).Error
+}
+
+func (r *pgProjects) ListEmployees(ctx context.Context, projectID int) ([]db.ProjectEmployee, error) {
+	var links []db.ProjectEmployee
+	err := r.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&links).Error
+	return links, err
+}
+
+func (r *pgProjects) GetEmployee(ctx context.Context, projectID int, employeeID int) (*db.ProjectEmployee, error) {
+	return first[db.ProjectEmployee](r.db.WithContext(ctx).Where("project_id = ? AND employee_id = ?", projectID, employeeID))
+}
+
+func (r *pgProjects) AddEmployee(ctx context.Context, link *db.ProjectEmployee) error {
+	err := addLink(ctx, r.db, link, []string{"project_id", "employee_id"}, "role")
+	return translate(err, "employee %d is already in project %d", link.EmployeeID, link.ProjectID)
+}
+
+func (r *pgProjects) SaveEmployee(ctx context.Context, link *db.ProjectEmployee) error {
+	return r.db.WithContext(ctx).Save(link).Error
+}
+
+func (r *pgProjects) RemoveEmployee(ctx context.Context, projectID int, employeeID int) error {
+	return r.db.WithContext(ctx).Where("project_id = ? AND employee_id = ?", projectID, employeeID).Delete(&db.ProjectEmployee{}).Error
+}
+
+type pgTeams struct {
+	db *gorm.DB
+}
+
+func (r *pgTeams) List(ctx context.Context, filter *model.TeamFilter, page db.PageArgs) (*db.Page[db.Team], error) {
+	query := r.db.WithContext(ctx).Model(&db.Team{}).Scopes(db.TeamFilterScope(filter))
+	return db.Paginate(query, db.TeamSorts, page)
+}
+
+func (r *pgTeams) Get(ctx context.Context, id int) (*db.Team, error) {
+	return first[db.Team](r.db.WithContext(ctx), id)
+}
+
+func (r *pgTeams) ListByProjects(ctx context.Context, projectIDs []int) (map[int][]db.Team, error) {
+	var links []db.ProjectTeam
+	if err := r.db.WithContext(ctx).Where("project_id IN ?", projectIDs).Find(&links).Error; err != nil {
+		return nil, err
+	}
+	return linked(ctx, r.db, links,
+		func(link db.ProjectTeam) (int, int) { return link.ProjectID, link.TeamID },
+		func(team db.Team) int { return team.ID })
+}
+
+func (r *pgTeams) Create(ctx context.Context, team *db.Team) error {
+	return r.db.WithContext(ctx).Create(team).Error
+}
+
+func (r *pgTeams) Save(ctx context.Context, team *db.Team) error {
+	return r.db.WithContext(ctx).Save(team).Error
+}
+
+func (r *pgTeams) Delete(ctx context.Context, team *db.Team) error {
+	return cache.Transaction(ctx, r.db, func(tx *gorm.DB) error {
+		return softDelete(tx, team, team.ID, teamDependents)
+	})
+}
+
+func (r *pgTeams) ListTrashed(ctx context.Context, page db.PageArgs) (*db.Page[db.Team], error) {
+	return db.Paginate(trashed(r.db.WithContext(ctx).Model(&db.Team{}), "teams"), db.TeamSorts, page)
+}
+
+func (r *pgTeams) GetTrashed(ctx context.Context, id int) (*db.Team, error) {
+	return first[db.Team](trashed(r.db.WithContext(ctx), "teams"), id)
+}
+
+func (r *pgTeams) Restore(ctx context.Context, team *db.Team) error {
+	return cache.Transaction(ctx, r.db, func(tx *gorm.DB) error {
+		return restore(tx, team, team.ID, team.DeletedAt, teamDependents)
+	})
+}
+
+func (r *pgTeams) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	var purged int64
+	err := cache.Transaction(ctx, r.db, func(tx *gorm.DB) error {
+		if _, err := purge(tx, &db.TeamEngineer{}, cutoff); err != nil {
+			return err
+		}
+
+		var err error
+		purged, err = purge(tx, &db.Team{}, cutoff, teamCascades...)
+		return err
+	})
+	return purged, err
+}
+
+func (r *pgTeams) ListEngineers(ctx context.Context, teamID int) ([]db.TeamEngineer, error) {
+	var links []db.TeamEngineer
+	err := r.db.WithContext(ctx).Where("team_id = ?", teamID).Find(&links).Error
+	return links, err
+}
+
+func (r *pgTeams) AddEngineer(ctx context.Context, link *db.TeamEngineer) error {
+	err := addLink(ctx, r.db, link, []string{"team_id", "engineer_id"})
+	return translate(err, "engineer %d is already in team %d", link.EngineerID, link.TeamID)
+}
+
+func (r *pgTeams) RemoveEngineer(ctx context.Context, teamID int, engineerID int) error {
+	return r.db.WithContext(ctx).Where("team_id = ? AND engineer_id = ?", teamID, engineerID).Delete(&db.TeamEngineer{}).Error
+}
+
+type pgTickets struct {
+	db     *gorm.DB
+	policy *policy.Engine
+}
+
+func (r *pgTickets) visible(ctx context.Context, viewer *model.AuthUser) *gorm.DB {
+	return r.policy.ScopeTickets(ctx, viewer, r.db.WithContext(ctx).Model(&db.Ticket{}))
+}
+
+func (r *pgTickets) List(ctx context.Context, viewer *model.AuthUser, filter *model.TicketFilter, page db.PageArgs) (*db.Page[db.Ticket], error) {
+	return db.Paginate(r.visible(ctx, viewer).Scopes(db.TicketFilterScope(filter)), db.TicketSorts, page)
+}
+
+func (r *pgTickets) GetVisible(ctx context.Context, viewer *model.AuthUser, id int) (*db.Ticket, error) {
+	return first[db.Ticket](r.visible(ctx, viewer), id)
+}
+
+func (r *pgTickets) ListByProjects(ctx context.Context, viewer *model.AuthUser, projectIDs []int) (map[int][]db.Ticket, error) {
+	var tickets []db.Ticket
+	if err := r.visible(ctx, viewer).Where("tickets.project_id IN ?", projectIDs).Order("tickets.created_at, tickets.id").Find(&tickets).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[int][]db.Ticket)
+	for _, ticket := range tickets {
+		result[ticket.ProjectID] = append(result[ticket.ProjectID], ticket)
+	}
+	return result, nil
+}
+
+func (r *pgTickets) Get(ctx context.Context, id int) (*db.Ticket, error) {
+	return first[db.Ticket](r.db.WithContext(ctx), id)
+}
+
+func (r *pgTickets) Create(ctx context.Context, ticket *db.Ticket) error {
+	return r.db.WithContext(ctx).Create(ticket).Error
+}
+
+func (r *pgTickets) Save(ctx context.Context, ticket *db.Ticket) error {
+	return r.db.WithContext(ctx).Save(ticket).Error
+}
+
+func (r *pgTickets) Delete(ctx context.Context, ticket *db.Ticket) error {
+	return cache.Transaction(ctx, r.db, func(tx *gorm.DB) error {
+		return softDelete(tx, ticket, ticket.ID, ticketDependents)
+	})
+}
+
+func (r *pgTickets) ListTrashed(ctx context.Context, viewer *model.AuthUser, page db.PageArgs) (*db.Page[db.Ticket], error) {
+	return db.Paginate(trashed(r.visible(ctx, viewer), "tickets"), db.TicketSorts, page)
+}
+
+func (r *pgTickets) GetTrashed(ctx context.Context, id int) (*db.Ticket, error) {
+	return first[db.Ticket](trashed(r.db.WithContext(ctx), "tickets"), id)
+}
+
+func (r *pgTickets) Restore(ctx context.Context, ticket *db.Ticket) error {
+	return cache.Transaction(ctx, r.db, func(tx *gorm.DB) error {
+		return restore(tx, ticket, ticket.ID, ticket.DeletedAt, ticketDependents)
+	})
+}
+
+func (r *pgTickets) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	return purge(r.db.WithContext(ctx), &db.Ticket{}, cutoff, commentCascades...)
+}
+
+type pgTasks struct {
+	db     *gorm.DB
+	policy *policy.Engine
+}
+
+func (r *pgTasks) visible(ctx context.Context, viewer *model.AuthUser) *gorm.DB {
+	return r.policy.ScopeTasks(ctx, viewer, r.db.WithContext(ctx).Model(&db.Task{}))
+}
+
+func (r *pgTasks) List(ctx context.Context, viewer *model.AuthUser, filter *model.TaskFilter, page db.PageArgs) (*db.Page[db.Task], error) {
+	return db.Paginate(r.visible(ctx, viewer).Scopes(db.TaskFilterScope(filter)), db.TaskSorts, page)
+}
+
+func (r *pgTasks) GetVisible(ctx context.Context, viewer *model.AuthUser, id int) (*db.Task, error) {
+	return first[db.Task](r.visible(ctx, viewer), id)
+}
+
+func (r *pgTasks) Get(ctx context.Context, id int) (*db.Task, error) {
+	return first[db.Task](r.db.WithContext(ctx), id)
+}
+
+func (r *pgTasks) Create(ctx context.Context, task *db.Task) error {
+	return r.db.WithContext(ctx).Create(task).Error
+}
+
+func (r *pgTasks) Save(ctx context.Context, task *db.Task) error {
+	return r.db.WithContext(ctx).Save(task).Error
+}
+
+func (r *pgTasks) Delete(ctx context.Context, task *db.Task) error {
+	return cache.Transaction(ctx, r.db, func(tx *gorm.DB) error {
+		return softDelete(tx, task, task.ID, taskDependents)
+	})
+}
+
+func (r *pgTasks) ListTrashed(ctx context.Context, viewer *model.AuthUser, page db.PageArgs) (*db.Page[db.Task], error) {
+	return db.Paginate(trashed(r.visible(ctx, viewer), "tasks"), db.TaskSorts, page)
+}
+
+func (r *pgTasks) GetTrashed(ctx context.Context, id int) (*db.Task, error) {
+	return first[db.Task](trashed(r.db.WithContext(ctx), "tasks"), id)
+}
+
+func (r *pgTasks) Restore(ctx context.Context, task *db.Task) error {
+	return cache.Transaction(ctx, r.db, func(tx *gorm.DB) error {
+		return restore(tx, task, task.ID, task.DeletedAt, taskDependents)
+	})
+}
+
+func (r *pgTasks) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	return purge(r.db.WithContext(ctx), &db.Task{}, cutoff, commentCascades...)
+}
+
+type pgNotifications struct {
+	db *gorm.DB
+}
+
+func (r *pgNotifications) List(ctx context.Context, employeeID int, filter *model.NotificationFilter, page db.PageArgs) (*db.Page[db.Notification], error) {
+	query := r.db.WithContext(ctx).Model(&db.Notification{}).
+		Where("employee_id = ?", employeeID).
+		Scopes(db.NotificationFilterScope(filter))
+	return db.Paginate(query, db.NotificationSorts, page)
+}
+
+func (r *pgNotifications) Get(ctx context.Context, id int) (*db.Notification, error) {
+	return first[db.Notification](r.db.WithContext(ctx), id)
+}
+
+func (r *pgNotifications) Create(ctx context.Context, notification *db.Notification) error {
+	return r.db.WithContext(ctx).Create(notification).Error
+}
+
+func (r *pgNotifications) Save(ctx context.Context, notification *db.Notification) error {
+	return r.db.WithContext(ctx).Save(notification).Error
+}
+
+type pgWorkflows struct {
+	db *gorm.DB
+}
+
+func (r *pgWorkflows) Get(ctx context.Context, projectID int) (*db.Workflow, error) {
+	return first[db.Workflow](r.db.WithContext(ctx).Where("project_id = ?", projectID))
+}
+
+func (r *pgWorkflows) Save(ctx context.Context, workflow *db.Workflow) error {
+	err := r.db.WithContext(ctx).Save(workflow).Error
+	return translate(err, "project %d already has a workflow", workflow.ProjectID)
+}
+
+func (r *pgWorkflows) Delete(ctx context.Context, projectID int) error {
+	return r.db.WithContext(ctx).Where("project_id = ?", projectID).Delete(&db.Workflow{}).Error
+}
+
+type pgAudit struct {
+	db *gorm.DB
+}
+
+func (r *pgAudit) List(ctx context.Context, filter *model.AuditLogFilter, page db.PageArgs) (*db.Page[db.AuditEntry], error) {
+	query := r.db.WithContext(ctx).Model(&db.AuditEntry{}).Scopes(db.AuditLogFilterScope(filter))
+	return db.Paginate(query, db.AuditEntrySorts, page)
+}
+
+func (r *pgAudit) ListByEntities(ctx context.Context, entityType string, ids []int) (map[int][]db.AuditEntry, error) {
+	entityIDs := make([]string, len(ids))
+	for i, id := range ids {
+		entityIDs[i] = strconv.Itoa(id)
+	}
+
+	var entries []db.AuditEntry
+	err := r.db.WithContext(ctx).
+		Where("entity_type = ? AND entity_id IN ?", entityType, entityIDs).
+		Order("created_at, id").
+		Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int][]db.AuditEntry)
+	for _, entry := range entries {
+		id, _ := strconv.Atoi(entry.EntityID)
+		result[id] = append(result[id], entry)
+	}
+	return result, nil
+}
+
+func (r *pgAudit) Create(ctx context.Context, entry *db.AuditEntry) error {
+	return r.db.WithContext(ctx).Create(entry).Error
+}
+
+type pgComments struct {
+	db *gorm.DB
+}
+
+func (r *pgComments) ListByTicket(ctx context.Context, ticketID int, page db.PageArgs) (*db.Page[db.Comment], error) {
+	query := r.db.WithContext(ctx).Model(&db.Comment{}).Where("ticket_id = ? AND parent_id IS NULL", ticketID)
+	return db.Paginate(query, db.CommentSorts, page)
+}
+
+func (r *pgComments) ListByTask(ctx context.Context, taskID int, page db.PageArgs) (*db.Page[db.Comment], error) {
+	query := r.db.WithContext(ctx).Model(&db.Comment{}).Where("task_id = ? AND parent_id IS NULL", taskID)
+	return db.Paginate(query, db.CommentSorts, page)
+}
+
+func (r *pgComments) ListReplies(ctx context.Context, parentIDs []int) (map[int][]db.Comment, error) {
+	var replies []db.Comment
+	if err := r.db.WithContext(ctx).Where("parent_id IN ?", parentIDs).Order("created_at, id").Find(&replies).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[int][]db.Comment)
+	for _, reply := range replies {
+		result[*reply.ParentID] = append(result[*reply.ParentID], reply)
+	}
+	return result, nil
+}
+
+func (r *pgComments) ListRevisions(ctx context.Context, commentIDs []int) (map[int][]db.CommentRevision, error) {
+	var revisions []db.CommentRevision
+	if err := r.db.WithContext(ctx).Where("comment_id IN ?", commentIDs).Order("created_at, id").Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[int][]db.CommentRevision)
+	for _, revision := range revisions {
+		result[revision.CommentID] = append(result[revision.CommentID], revision)
+	}
+	return result, nil
+}
+
+func (r *pgComments) Get(ctx context.Context, id int) (*db.Comment, error) {
+	return first[db.Comment](r.db.WithContext(ctx), id)
+}
+
+func (r *pgComments) Create(ctx context.Context, comment *db.Comment) error {
+	return translate(r.db.WithContext(ctx).Create(comment).Error, "comment already exists")
+}
+
+func (r *pgComments) Edit(ctx context.Context, comment *db.Comment, body string) error {
+	return cache.Transaction(ctx, r.db, func(tx *gorm.DB) error {
+		if err := tx.Create(&db.CommentRevision{CommentID: comment.ID, Body: comment.Body}).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		comment.Body = body
+		comment.EditedAt = &now
+		return tx.Save(comment).Error
+	})
+}
+
+// Delete walks the thread below the comment and deletes it with one timestamp
+func (r *pgComments) Delete(ctx context.Context, comment *db.Comment) error {
+	return cache.Transaction(ctx, r.db, func(tx *gorm.DB) error {
+		var ids []int
+		err := tx.Raw(`WITH RECURSIVE thread AS (
+			SELECT id FROM comments WHERE id = ? AND deleted_at IS NULL
+			UNION ALL
+			SELECT c.id FROM comments c JOIN thread t ON c.parent_id = t.id WHERE c.deleted_at IS NULL
+		) SELECT id FROM thread`, comment.ID).Scan(&ids).Error
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return ErrNotFound
+		}
+
+		now := time.Now()
+		tx = tx.Session(&gorm.Session{NowFunc: func() time.Time { return now }})
+		if err := tx.Where("id IN ?", ids).Delete(&db.Comment{}).Error; err != nil {
+			return err
+		}
+		comment.DeletedAt = gorm.DeletedAt{Time: now, Valid: true}
+		return nil
+	})
+}
+
+// Purge leaves replies and revisions to the ON DELETE CASCADE of their foreign keys
+func (r *pgComments) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	return purge(r.db.WithContext(ctx), &db.Comment{}, cutoff, commentCascades...)
+}
@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+)
+
+// ErrNotFound is returned when a row does not exist, or is not visible to the caller
+var ErrNotFound = errors.New("record not found")
+
+// Repositories is the data layer the resolvers depend on, one repository per aggregate.
+//
+// Deletes are soft: a deleted row, with the rows that depend on it, moves to the trash,
+// where ListTrashed and GetTrashed find it. Restore brings back the row and the dependents
+// deleted with it, but not those deleted on their own before. Purge removes rows that
+// have been in the trash since before a cutoff for good.
+type Repositories struct {
+	Employees     EmployeeRepository
+	Projects      ProjectRepository
+	Teams         TeamRepository
+	Tickets       TicketRepository
+	Tasks         TaskRepository
+	Notifications NotificationRepository
+	Workflows     WorkflowRepository
+	Audit         AuditRepository
+	Comments      CommentRepository
+}
+
+// EmployeeRepository stores employees
+type EmployeeRepository interface {
+	List(ctx context.Context, filter *model.EmployeeFilter, page db.PageArgs) (*db.Page[db.Employee], error)
+	Get(ctx context.Context, id int) (*db.Employee, error)
+	GetByEmail(ctx context.Context, email string) (*db.Employee, error)
+	// GetMany returns the employees that exist among ids, keyed by id
+	GetMany(ctx context.Context, ids []int) (map[int]*db.Employee, error)
+	// ListByProject returns the employees whose assigned project is projectID
+	ListByProject(ctx context.Context, projectID int) ([]db.Employee, error)
+	// ListByTeams returns the engineers of each team, keyed by team id
+	ListByTeams(ctx context.Context, teamIDs []int) (map[int][]db.Employee, error)
+	Create(ctx context.Context, employee *db.Employee) error
+	// Save writes every field. Saving an inactive employee ends their sessions.
+	Save(ctx context.Context, employee *db.Employee) error
+	// Delete removes the employee with their team and project memberships, and ends
+	// their sessions
+	Delete(ctx context.Context, employee *db.Employee) error
+
+	ListTrashed(ctx context.Context, page db.PageArgs) (*db.Page[db.Employee], error)
+	GetTrashed(ctx context.Context, id int) (*db.Employee, error)
+	Restore(ctx context.Context, employee *db.Employee) error
+	Purge(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+// ProjectRepository stores projects and their team and employee memberships
+type ProjectRepository interface {
+	List(ctx context.Context, filter *model.ProjectFilter, page db.PageArgs) (*db.Page[db.Project], error)
+	Get(ctx context.Context, id int) (*db.Project, error)
+	// ListByEmployees returns the projects each employee is a member of, keyed by employee id
+	ListByEmployees(ctx context.Context, employeeIDs []int) (map[int][]db.Project, error)
+	Create(ctx context.Context, project *db.Project) error
+	Save(ctx context.Context, project *db.Project) error
+	// Delete removes the project with its tickets, tasks and memberships
+	Delete(ctx context.Context, project *db.Project) error
+
+	ListTrashed(ctx context.Context, page db.PageArgs) (*db.Page[db.Project], error)
+	GetTrashed(ctx context.Context, id int) (*db.Project, error)
+	Restore(ctx context.Context, project *db.Project) error
+	// Purge also removes the trashed tickets, tasks and memberships of purged projects,
+	// and memberships removed before cutoff
+	Purge(ctx context.Context, cutoff time.Time) (int64, error)
+
+	ListTeams(ctx context.Context, projectID int) ([]db.ProjectTeam, error)
+	AddTeam(ctx context.Context, link *db.ProjectTeam) error
+	RemoveTeam(ctx context.Context, projectID int, teamID int) error
+
+	ListEmployees(ctx context.Context, projectID int) ([]db.ProjectEmployee, error)
+	GetEmployee(ctx context.Context, projectID int, employeeID int) (*db.ProjectEmployee, error)
+	AddEmployee(ctx context.Context, link *db.ProjectEmployee) error
+	SaveEmployee(ctx context.Context, link *db.ProjectEmployee) error
+	RemoveEmployee(ctx context.Context, projectID int, employeeID int) error
+}
+
+// TeamRepository stores teams and their engineers
+type TeamRepository interface {
+	List(ctx context.Context, filter *model.TeamFilter, page db.PageArgs) (*db.Page[db.Team], error)
+	Get(ctx context.Context, id int) (*db.Team, error)
+	// ListByProjects returns the teams of each project, keyed by project id
+	ListByProjects(ctx context.Context, projectIDs []int) (map[int][]db.Team, error)
+	Create(ctx context.Context, team *db.Team) error
+	Save(ctx context.Context, team *db.Team) error
+	// Delete removes the team with its engineer and project memberships
+	Delete(ctx context.Context, team *db.Team) error
+
+	ListTrashed(ctx context.Context, page db.PageArgs) (*db.Page[db.Team], error)
+	GetTrashed(ctx context.Context, id int) (*db.Team, error)
+	Restore(ctx context.Context, team *db.Team) error
+	// Purge also removes the memberships of purged teams, and engineers removed from a
+	// team before cutoff
+	Purge(ctx context.Context, cutoff time.Time) (int64, error)
+
+	ListEngineers(ctx context.Context, teamID int) ([]db.TeamEngineer, error)
+	AddEngineer(ctx context.Context, link *db.TeamEngineer) error
+	RemoveEngineer(ctx context.Context, teamID int, engineerID int) error
+}
+
+// TicketRepository stores tickets. Methods taking a viewer only return rows the policy
+// engine lets that caller see.
+type TicketRepository interface {
+	List(ctx context.Context, viewer *model.AuthUser, filter *model.TicketFilter, page db.PageArgs) (*db.Page[db.Ticket], error)
+	GetVisible(ctx context.Context, viewer *model.AuthUser, id int) (*db.Ticket, error)
+	// ListByProjects returns the visible tickets of each project, keyed by project id
+	ListByProjects(ctx context.Context, viewer *model.AuthUser, projectIDs []int) (map[int][]db.Ticket, error)
+	Get(ctx context.Context, id int) (*db.Ticket, error)
+	Create(ctx context.Context, ticket *db.Ticket) error
+	Save(ctx context.Context, ticket *db.Ticket) error
+	Delete(ctx context.Context, ticket *db.Ticket) error
+
+	// ListTrashed pages through the deleted tickets viewer could see
+	ListTrashed(ctx context.Context, viewer *model.AuthUser, page db.PageArgs) (*db.Page[db.Ticket], error)
+	GetTrashed(ctx context.Context, id int) (*db.Ticket, error)
+	Restore(ctx context.Context, ticket *db.Ticket) error
+	Purge(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+// TaskRepository stores tasks, with the same visibility rules as TicketRepository
+type TaskRepository interface {
+	List(ctx context.Context, viewer *model.AuthUser, filter *model.TaskFilter, page db.PageArgs) (*db.Page[db.Task], error)
+	GetVisible(ctx context.Context, viewer *model.AuthUser, id int) (*db.Task, error)
+	Get(ctx context.Context, id int) (*db.Task, error)
+	Create(ctx context.Context, task *db.Task) error
+	Save(ctx context.Context, task *db.Task) error
+	Delete(ctx context.Context, task *db.Task) error
+
+	ListTrashed(ctx context.Context, viewer *model.AuthUser, page db.PageArgs) (*db.Page[db.Task], error)
+	GetTrashed(ctx context.Context, id int) (*db.Task, error)
+	Restore(ctx context.Context, task *db.Task) error
+	Purge(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+// NotificationRepository stores notifications
+type NotificationRepository interface {
+	List(ctx context.Context, employeeID int, filter *model.NotificationFilter, page db.PageArgs) (*db.Page[db.Notification], error)
+	Get(ctx context.Context, id int) (*db.Notification, error)
+	Create(ctx context.Context, notification *db.Notification) error
+	Save(ctx context.Context, notification *db.Notification) error
+}
+
+// WorkflowRepository stores the workflows projects define for their tickets and tasks
+type WorkflowRepository interface {
+	// Get returns the workflow of a project, or ErrNotFound when it uses the default one
+	Get(ctx context.Context, projectID int) (*db.Workflow, error)
+	// Save creates or replaces the workflow of workflow.ProjectID
+	Save(ctx context.Context, workflow *db.Workflow) error
+	// Delete returns the project to the default workflow
+	Delete(ctx context.Context, projectID int) error
+}
+
+// AuditRepository reads the audit log. Writes through GORM are recorded by the audit
+// package's callbacks; Create is for stores without them.
+type AuditRepository interface {
+	List(ctx context.Context, filter *model.AuditLogFilter, page db.PageArgs) (*db.Page[db.AuditEntry], error)
+	// ListByEntities returns the entries of each entity of a type, keyed by id, oldest first
+	ListByEntities(ctx context.Context, entityType string, ids []int) (map[int][]db.AuditEntry, error)
+	Create(ctx context.Context, entry *db.AuditEntry) error
+}
+
+// CommentRepository stores comments on tickets and tasks. Callers check that the ticket
+// or task is visible before reading or writing its comments.
+type CommentRepository interface {
+	// ListByTicket pages through the top-level comments of a ticket
+	ListByTicket(ctx context.Context, ticketID int, page db.PageArgs) (*db.Page[db.Comment], error)
+	// ListByTask pages through the top-level comments of a task
+	ListByTask(ctx context.Context, taskID int, page db.PageArgs) (*db.Page[db.Comment], error)
+	// ListReplies returns the replies to each comment, keyed by parent id, oldest first
+	ListReplies(ctx context.Context, parentIDs []int) (map[int][]db.Comment, error)
+	// ListRevisions returns the earlier bodies of each comment, keyed by comment id, oldest first
+	ListRevisions(ctx context.Context, commentIDs []int) (map[int][]db.CommentRevision, error)
+	Get(ctx context.Context, id int) (*db.Comment, error)
+	Create(ctx context.Context, comment *db.Comment) error
+	// Edit replaces the body of a comment, keeping the previous one as a revision
+	Edit(ctx context.Context, comment *db.Comment, body string) error
+	// Delete deletes a comment with every reply below it
+	Delete(ctx context.Context, comment *db.Comment) error
+	Purge(ctx context.Context, cutoff time.Time) (int64, error)
+}
@@ -1,73 +1,125 @@
 package main
 
 import (
-	"bufio"
-	"log"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"os"
-	"strings"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/JonJenson-MFIn/project-management-system-api/audit"
+	"github.com/JonJenson-MFIn/project-management-system-api/auth"
+	"github.com/JonJenson-MFIn/project-management-system-api/cache"
+	"github.com/JonJenson-MFIn/project-management-system-api/config"
 	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/db/migrations"
 	"github.com/JonJenson-MFIn/project-management-system-api/directives"
 	"github.com/JonJenson-MFIn/project-management-system-api/graph/generated"
 	"github.com/JonJenson-MFIn/project-management-system-api/graph/resolvers"
+	"github.com/JonJenson-MFIn/project-management-system-api/health"
+	"github.com/JonJenson-MFIn/project-management-system-api/loaders"
+	"github.com/JonJenson-MFIn/project-management-system-api/logging"
+	"github.com/JonJenson-MFIn/project-management-system-api/metrics"
 	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"github.com/JonJenson-MFIn/project-management-system-api/policy"
+	"github.com/JonJenson-MFIn/project-management-system-api/repository"
+	"github.com/JonJenson-MFIn/project-management-system-api/tracing"
 	"github.com/vektah/gqlparser/v2/ast"
 )
 
-const defaultPort = "8080"
+func main() {
+	// Settings come from defaults, an optional YAML file, the environment and flags
+	cfg, args, err := config.Load(os.Args[1:], os.Getenv)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		fatal("invalid configuration", err)
+	}
+	slog.SetDefault(logging.New(os.Stderr, cfg.Logging.SlogLevel()))
 
-func loadEnvFile(filename string) {
-	file, err := os.Open(filename)
+	// Spans go to the configured exporter; trace context is always propagated
+	flushTraces, err := tracing.Setup(context.Background(), cfg.Tracing)
 	if err != nil {
-		log.Printf("Warning: Could not open %s: %v", filename, err)
-		return
+		fatal("failed to configure tracing", err)
 	}
-	defer file.Close()
 
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" || strings.HasPrefix(line, "#") {
-			continue
-		}
+	// Connect to database
+	if err := db.ConnectDatabase(cfg.Database); err != nil {
+		fatal("failed to connect to database", err)
+	}
+	if err := db.DB.Use(tracing.GormPlugin{}); err != nil {
+		fatal("failed to register SQL tracing", err)
+	}
 
-		parts := strings.SplitN(line, "=", 2)
-		if len(parts) == 2 {
-			key := strings.TrimSpace(parts[0])
-			value := strings.TrimSpace(parts[1])
-			os.Setenv(key, value)
+	if len(args) > 0 && args[0] == "migrate" {
+		if err := runMigrate(cfg, args[1:]); err != nil {
+			fatal("migrate failed", err)
 		}
+		return
 	}
 
-	if err := scanner.Err(); err != nil {
-		log.Printf("Warning: Error reading %s: %v", filename, err)
+	// Refuse to serve against a schema this build does not match
+	migrator, err := migrations.New(db.DB)
+	if err != nil {
+		fatal("failed to load migrations", err)
+	}
+	if err := migrator.Verify(context.Background()); err != nil {
+		fatal("database schema check failed", err)
 	}
-}
 
-func main() {
-	// Load environment variables from config file
-	loadEnvFile("config.env")
+	// Load token signing keys
+	tokens, err := auth.NewTokenManager(cfg.Auth.Ed25519PrivateKeyFile, cfg.Auth.Secret, cfg.Auth.Issuer, cfg.Auth.AccessTTL)
+	if err != nil {
+		fatal("failed to configure access tokens", err)
+	}
+	sessions := auth.NewSessionManager(db.DB, tokens, cfg.Auth.RefreshTTL)
+
+	passwords := auth.NewPasswordHasher(cfg.Auth.BcryptCost, auth.PasswordPolicy{
+		MinLength:     cfg.Auth.Password.MinLength,
+		RequireUpper:  cfg.Auth.Password.RequireUpper,
+		RequireLower:  cfg.Auth.Password.RequireLower,
+		RequireDigit:  cfg.Auth.Password.RequireDigit,
+		RequireSymbol: cfg.Auth.Password.RequireSymbol,
+	})
 
-	// Connect to database
-	db.ConnectDatabase()
+	// Cached responses are dropped whenever GORM writes to a table they were built from
+	responseCache := cache.NewLRU(1000)
+	if err := cache.RegisterInvalidation(db.DB, responseCache); err != nil {
+		fatal("failed to register cache invalidation", err)
+	}
 
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = defaultPort
+	// Every write records who changed what in the audit log, in the same transaction
+	if err := audit.Register(db.DB); err != nil {
+		fatal("failed to register audit logging", err)
 	}
 
+	engine := policy.NewEngine(db.DB)
+	repos := repository.NewPostgres(db.DB, engine)
+
 	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
 		Resolvers: &resolvers.Resolver{
-			DB: db.DB,
+			Repos:          repos,
+			Sessions:       sessions,
+			Passwords:      passwords,
+			Policy:         engine,
+			TrashRetention: cfg.Trash.Retention,
 		},
 		Directives: generated.DirectiveRoot{
-			Auth: directives.AuthDirective,
+			Auth:      directives.AuthDirective,
+			Cache:     directives.NewResponseCache(responseCache).Directive,
+			RateLimit: directives.NewRateLimiter().Directive,
 		},
 	}))
 
@@ -77,14 +129,105 @@ func main() {
 
 	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
 
+	// Every error carries extensions.code; production hides the cause of internal ones
+	srv.SetErrorPresenter(resolvers.ErrorPresenter(cfg.Server.Environment == config.EnvironmentProduction))
+	srv.SetRecoverFunc(resolvers.Recover)
+
+	// Latency, complexity and error counts per operation, plus the connection pool
+	telemetry := metrics.New()
+	sqlDB, err := db.DB.DB()
+	if err != nil {
+		fatal("failed to get underlying SQL DB", err)
+	}
+	if err := telemetry.RegisterDB(sqlDB, "postgres"); err != nil {
+		fatal("failed to register database metrics", err)
+	}
+	srv.Use(telemetry)
+	srv.Use(tracing.Extension{})
+
+	// @constraint violations are reported together before the resolver runs
+	srv.Use(&directives.Constraints{})
+
 	srv.Use(extension.Introspection{})
 	srv.Use(extension.AutomaticPersistedQuery{
 		Cache: lru.New[string](100),
 	})
 
-	http.Handle("/", playground.Handler("GraphQL playground", "/query"))
-	http.Handle("/query", middleware.AuthMiddleware(srv))
+	// Ready means the database answers and its schema matches this build
+	readiness := health.Ready(2*time.Second,
+		health.Check{Name: "database", Run: db.Ping},
+		health.Check{Name: "migrations", Run: migrator.Verify},
+	)
+
+	mux := http.NewServeMux()
+	mux.Handle("/", playground.Handler("GraphQL playground", "/query"))
+	mux.Handle("/query", middleware.RequestID(middleware.ClientIP(cfg.Server.TrustProxyHeaders)(middleware.AccessLog(middleware.CORS(cfg.Server.CORSAllowedOrigins)(middleware.AuthMiddleware(sessions)(loaders.Middleware(repos)(srv)))))))
+	mux.Handle("/healthz", health.Live())
+	mux.Handle("/readyz", readiness)
+	mux.Handle("/metrics", telemetry.Handler())
+
+	server := &http.Server{
+		Addr:              ":" + strconv.Itoa(cfg.Server.Port),
+		Handler:           mux,
+		ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
+		ReadTimeout:       cfg.Server.ReadTimeout,
+		WriteTimeout:      cfg.Server.WriteTimeout,
+		IdleTimeout:       cfg.Server.IdleTimeout,
+	}
+
+	slog.Info("listening", "addr", server.Addr, "playground", fmt.Sprintf("http://localhost:%d/", cfg.Server.Port))
+	if err := serve(server, cfg.Server.ShutdownTimeout, flushTraces); err != nil {
+		fatal("server stopped", err)
+	}
+}
+
+// fatal logs err and exits; deferred functions do not run
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
 
-	log.Printf("connect to http://localhost:%s/ for GraphQL playground", port)
-	log.Fatal(http.ListenAndServe(":"+port, nil))
+// serve runs the server until SIGINT or SIGTERM, then stops accepting connections,
+// waits up to shutdownTimeout for in-flight requests, closes the database pool and
+// flushes pending spans
+func serve(server *http.Server, shutdownTimeout time.Duration, flushTraces func(context.Context) error) error {
+	stop, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-stop.Done():
+	}
+	// A second signal kills the process instead of waiting for the drain
+	cancel()
+
+	slog.Info("shutting down, draining requests", "timeout", shutdownTimeout)
+
+	ctx, cancelShutdown := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancelShutdown()
+	shutdownErr := server.Shutdown(ctx)
+	if shutdownErr != nil {
+		shutdownErr = fmt.Errorf("failed to drain requests: %w", shutdownErr)
+	}
+
+	if err := db.CloseDatabase(); err != nil {
+		shutdownErr = errors.Join(shutdownErr, fmt.Errorf("failed to close database: %w", err))
+	}
+
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFlush()
+	if err := flushTraces(flushCtx); err != nil {
+		shutdownErr = errors.Join(shutdownErr, fmt.Errorf("failed to flush traces: %w", err))
+	}
+
+	if shutdownErr == nil {
+		slog.Info("shutdown complete")
+	}
+	return shutdownErr
 }
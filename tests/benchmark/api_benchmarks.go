@@ -9,6 +9,7 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/JonJenson-MFIn/project-management-system-api/graph/generated"
 	"github.com/JonJenson-MFIn/project-management-system-api/graph/resolvers"
+	"github.com/JonJenson-MFIn/project-management-system-api/repository"
 )
 
 func BenchmarkFullAPIEndpoint(b *testing.B) {
@@ -16,7 +17,7 @@ func BenchmarkFullAPIEndpoint(b *testing.B) {
 		handler.NewDefaultServer(
 			generated.NewExecutableSchema(
 				generated.Config{
-					Resolvers: &resolvers.Resolver{},
+					Resolvers: &resolvers.Resolver{Repos: repository.NewMemory().Repositories()},
 				},
 			),
 		),
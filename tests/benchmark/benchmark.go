@@ -2,40 +2,31 @@ package benchmark
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
-	"fmt"
 	"net/http/httptest"
 	"testing"
 
-	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/JonJenson-MFIn/project-management-system-api/directives"
 	"github.com/JonJenson-MFIn/project-management-system-api/graph/generated"
 	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
 	"github.com/JonJenson-MFIn/project-management-system-api/graph/resolvers"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"github.com/JonJenson-MFIn/project-management-system-api/repository"
 )
 
 type GraphQLRequest struct {
 	Query string `json:"query"`
 }
 
-type contextKey string
-
-const roleContextKey contextKey = "role"
-
 func BenchmarkAuthDirective(b *testing.B) {
 	srv := httptest.NewServer(
 		handler.NewDefaultServer(
 			generated.NewExecutableSchema(
 				generated.Config{
-					Resolvers: &resolvers.Resolver{},
+					Resolvers: &resolvers.Resolver{Repos: repository.NewMemory().Repositories()},
 					Directives: generated.DirectiveRoot{
-						Auth: func(ctx context.Context, obj interface{}, next graphql.Resolver, role model.Role) (interface{}, error) {
-							if ctx.Value(roleContextKey) != role {
-								return nil, fmt.Errorf("unauthorized")
-							}
-							return next(ctx)
-						},
+						Auth: directives.AuthDirective,
 					},
 				},
 			),
@@ -52,7 +43,7 @@ func BenchmarkAuthDirective(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		req := httptest.NewRequest("POST", "/", bytes.NewBuffer(body))
 		req.Header.Set("Content-Type", "application/json")
-		req = req.WithContext(context.WithValue(req.Context(), roleContextKey, model.RoleAdmin))
+		req = req.WithContext(middleware.WithUser(req.Context(), &model.AuthUser{ID: 1, Role: model.RoleAdmin}))
 
 		w := httptest.NewRecorder()
 		srv.Config.Handler.ServeHTTP(w, req)
@@ -0,0 +1,314 @@
+package tdd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/audit"
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/JonJenson-MFIn/project-management-system-api/logging"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// responseData sends request as user and returns the data of a response without errors
+func responseData(t *testing.T, srv http.Handler, user *model.AuthUser, request GraphQLRequest) map[string]interface{} {
+	t.Helper()
+	body, _ := json.Marshal(request)
+	req := httptest.NewRequest("POST", "/query", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(middleware.WithUser(req.Context(), user))
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+
+	var resp struct {
+		Data   map[string]interface{} `json:"data"`
+		Errors []presentedError       `json:"errors"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Errors) > 0 {
+		t.Fatalf("unexpected errors: %+v", resp.Errors)
+	}
+	return resp.Data
+}
+
+// capturedInserts records the SQL of every insert run against a dry-run database
+func capturedInserts(t *testing.T, database *gorm.DB) *[]string {
+	t.Helper()
+	var inserts []string
+	err := database.Callback().Create().After("gorm:create").Register("test:capture", func(tx *gorm.DB) {
+		inserts = append(inserts, tx.Dialector.Explain(tx.Statement.SQL.String(), tx.Statement.Vars...))
+	})
+	if err != nil {
+		t.Fatalf("failed to register capture callback: %v", err)
+	}
+	return &inserts
+}
+
+func TestAuditDiff(t *testing.T) {
+	created := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	before := map[string]interface{}{"title": "Fix login", "status": db.StatusNotStartedDB, "created_at": created, "assigned_to_id": intPtr(3)}
+	after := map[string]interface{}{"title": "Fix login", "status": db.StatusInProgressDB, "created_at": created.In(time.FixedZone("CET", 3600)), "assigned_to_id": nil}
+
+	changedBefore, changedAfter := audit.Diff(before, after)
+	if len(changedAfter) != 2 || changedAfter["status"] != db.StatusInProgressDB || changedAfter["assigned_to_id"] != nil {
+		t.Errorf("expected status and assignee to change, got %v", changedAfter)
+	}
+	if len(changedBefore) != 2 || changedBefore["status"] != db.StatusNotStartedDB {
+		t.Errorf("expected the previous status and assignee, got %v", changedBefore)
+	}
+}
+
+func TestAuditCallbacks(t *testing.T) {
+	ctx := middleware.WithUser(logging.WithRequestID(context.Background(), "req-9"), &model.AuthUser{ID: 7, Role: model.RoleAdmin})
+
+	auditInserts := func(t *testing.T, write func(database *gorm.DB)) []string {
+		t.Helper()
+		database := dryRunDB(t)
+		if err := audit.Register(database); err != nil {
+			t.Fatalf("failed to register audit logging: %v", err)
+		}
+		inserts := capturedInserts(t, database)
+		write(database.WithContext(ctx))
+
+		var entries []string
+		for _, insert := range *inserts {
+			if strings.HasPrefix(insert, `INSERT INTO "audit_entries"`) {
+				entries = append(entries, insert)
+			}
+		}
+		return entries
+	}
+
+	t.Run("Creates name the caller, entity and request", func(t *testing.T) {
+		entries := auditInserts(t, func(database *gorm.DB) {
+			database.Create(&db.Ticket{ProjectID: 2, Title: "Fix login", Status: db.StatusNotStartedDB})
+		})
+		if len(entries) != 1 {
+			t.Fatalf("expected one audit entry, got %v", entries)
+		}
+		for _, want := range []string{"7", "'Ticket'", "'CREATE'", "'req-9'", `"title":"Fix login"`} {
+			if !strings.Contains(entries[0], want) {
+				t.Errorf("expected %s in %s", want, entries[0])
+			}
+		}
+	})
+
+	// storeTicket stands in for the tickets table: queries read the stored row, and an
+	// update or delete that reaches the database swaps it for next
+	storeTicket := func(t *testing.T, database *gorm.DB, row, next db.Ticket) {
+		t.Helper()
+		read := func(tx *gorm.DB) {
+			dest := reflect.ValueOf(tx.Statement.Dest).Elem()
+			if dest.Kind() == reflect.Slice {
+				dest.Set(reflect.Append(dest, reflect.ValueOf(row)))
+			} else {
+				dest.Set(reflect.ValueOf(row))
+			}
+			tx.RowsAffected = 1
+		}
+		write := func(tx *gorm.DB) {
+			row = next
+			tx.RowsAffected = 1
+		}
+		callbacks := database.Callback()
+		for _, err := range []error{
+			callbacks.Query().Replace("gorm:query", read),
+			callbacks.Update().Replace("gorm:update", write),
+			callbacks.Delete().Replace("gorm:delete", write),
+		} {
+			if err != nil {
+				t.Fatalf("failed to stub the tickets table: %v", err)
+			}
+		}
+	}
+	// recorded decodes the before and after maps of an audit insert
+	recorded := func(t *testing.T, insert string) (before, after map[string]interface{}) {
+		t.Helper()
+		var maps []map[string]interface{}
+		for _, part := range strings.Split(insert, "'") {
+			var m map[string]interface{}
+			if strings.HasPrefix(part, "{") && json.Unmarshal([]byte(part), &m) == nil {
+				maps = append(maps, m)
+			}
+		}
+		switch {
+		case strings.Contains(insert, "'UPDATE'") && len(maps) == 2:
+			return maps[0], maps[1]
+		case strings.Contains(insert, "'DELETE'") && len(maps) == 1:
+			return maps[0], nil
+		}
+		t.Fatalf("unexpected audit insert %s", insert)
+		return nil, nil
+	}
+	created := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	stored := db.Ticket{ID: 6, ProjectID: 2, AssignedToID: intPtr(3), Title: "Fix login", Status: db.StatusNotStartedDB, Priority: "MEDIUM", CreatedAt: created}
+
+	t.Run("Updates record only the changed columns", func(t *testing.T) {
+		moved := stored
+		moved.Status = db.StatusInProgressDB
+		moved.AssignedToID = nil
+		entries := auditInserts(t, func(database *gorm.DB) {
+			storeTicket(t, database, stored, moved)
+			database.Model(&db.Ticket{ID: 6}).Updates(map[string]interface{}{"status": db.StatusInProgressDB, "assigned_to_id": nil})
+		})
+		if len(entries) != 1 {
+			t.Fatalf("expected one audit entry, got %v", entries)
+		}
+		before, after := recorded(t, entries[0])
+		wantBefore := map[string]interface{}{"status": "NOT_STARTED", "assigned_to_id": float64(3)}
+		wantAfter := map[string]interface{}{"status": "IN_PROGRESS", "assigned_to_id": nil}
+		if !reflect.DeepEqual(before, wantBefore) || !reflect.DeepEqual(after, wantAfter) {
+			t.Errorf("expected %v -> %v, got %v -> %v", wantBefore, wantAfter, before, after)
+		}
+		if !strings.Contains(entries[0], "'6'") {
+			t.Errorf("expected the entry to name ticket 6, got %s", entries[0])
+		}
+	})
+
+	t.Run("Updates that change nothing are not recorded", func(t *testing.T) {
+		entries := auditInserts(t, func(database *gorm.DB) {
+			storeTicket(t, database, stored, stored)
+			database.Model(&db.Ticket{ID: 6}).Update("title", "Fix login")
+		})
+		if len(entries) != 0 {
+			t.Errorf("expected no audit entry, got %v", entries)
+		}
+	})
+
+	t.Run("Deletes record the whole row before", func(t *testing.T) {
+		entries := auditInserts(t, func(database *gorm.DB) {
+			storeTicket(t, database, stored, stored)
+			database.Delete(&db.Ticket{ID: 6})
+		})
+		if len(entries) != 1 {
+			t.Fatalf("expected one audit entry, got %v", entries)
+		}
+		before, after := recorded(t, entries[0])
+		if after != nil || before["title"] != "Fix login" || before["status"] != "NOT_STARTED" || before["project_id"] != float64(2) || before["assigned_to_id"] != float64(3) {
+			t.Errorf("expected the deleted row as before and no after, got %v -> %v", before, after)
+		}
+	})
+
+	t.Run("Hidden fields stay out of the log", func(t *testing.T) {
+		entries := auditInserts(t, func(database *gorm.DB) {
+			database.Create(&db.Employee{Name: "New Hire", Email: "new@example.com", Password: "$2a$10$secret", Role: db.RoleEmployeeDB})
+		})
+		if len(entries) != 1 || strings.Contains(entries[0], "secret") || !strings.Contains(entries[0], "new@example.com") {
+			t.Errorf("expected an entry without the password hash, got %v", entries)
+		}
+	})
+
+	t.Run("The longest accepted request ID fits the log", func(t *testing.T) {
+		id := strings.Repeat("r", middleware.MaxRequestIDLength)
+		var entries []string
+		handler := middleware.RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			entries = auditInserts(t, func(database *gorm.DB) {
+				database.WithContext(r.Context()).Create(&db.Ticket{ProjectID: 2, Title: "Fix login", Status: db.StatusNotStartedDB})
+			})
+		}))
+		req := httptest.NewRequest("POST", "/query", nil)
+		req.Header.Set("X-Request-ID", id)
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		if len(entries) != 1 || !strings.Contains(entries[0], "'"+id+"'") {
+			t.Fatalf("expected an entry with the caller's request ID, got %v", entries)
+		}
+		parsed, err := schema.Parse(&db.AuditEntry{}, &sync.Map{}, schema.NamingStrategy{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var width int
+		fmt.Sscanf(string(parsed.LookUpField("request_id").DataType), "varchar(%d)", &width)
+		if width < middleware.MaxRequestIDLength {
+			t.Errorf("expected request_id to hold %d characters, got %s", middleware.MaxRequestIDLength, parsed.LookUpField("request_id").DataType)
+		}
+	})
+
+	t.Run("Sessions are not audited", func(t *testing.T) {
+		entries := auditInserts(t, func(database *gorm.DB) {
+			database.Create(&db.Session{EmployeeID: 7, RefreshTokenHash: "hash", ExpiresAt: time.Now()})
+		})
+		if len(entries) != 0 {
+			t.Errorf("expected no audit entry, got %v", entries)
+		}
+	})
+}
+
+func TestAuditLog(t *testing.T) {
+	ctx := context.Background()
+	admin := &model.AuthUser{ID: 1, Role: model.RoleAdmin}
+	engineer := &model.AuthUser{ID: 3, Role: model.RoleEmployee}
+
+	seed := func(t *testing.T) http.Handler {
+		t.Helper()
+		captureLogs(t)
+		repos := seedMemory(t)
+		entries := []db.AuditEntry{
+			{ActorID: intPtr(1), EntityType: "Ticket", EntityID: "6", Operation: db.AuditCreate, After: map[string]interface{}{"title": "Fix login"}},
+			{ActorID: intPtr(3), EntityType: "Ticket", EntityID: "6", Operation: db.AuditUpdate, Before: map[string]interface{}{"status": "NOT_STARTED"}, After: map[string]interface{}{"status": "IN_PROGRESS"}},
+			{ActorID: intPtr(1), EntityType: "Project", EntityID: "2", Operation: db.AuditUpdate, After: map[string]interface{}{"name": "Apollo"}},
+		}
+		for i := range entries {
+			if err := repos.Audit.Create(ctx, &entries[i]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return presentingServer(repos, true)
+	}
+
+	t.Run("Admins filter the log", func(t *testing.T) {
+		data := responseData(t, seed(t), admin, GraphQLRequest{Query: `{ auditLog(filter: { entityType: "Ticket", operation: UPDATE }) { totalCount edges { node { entityID actor { name } after } } } }`})
+		raw, _ := json.Marshal(data)
+		log := data["auditLog"].(map[string]interface{})
+		if log["totalCount"] != float64(1) || !strings.Contains(string(raw), "Eddie Engineer") || !strings.Contains(string(raw), `"status":"IN_PROGRESS"`) {
+			t.Errorf("expected the one ticket update by Eddie, got %s", raw)
+		}
+	})
+
+	t.Run("Only admins read the log", func(t *testing.T) {
+		got := firstError(t, seed(t), engineer, `{ auditLog { totalCount } }`)
+		if got.Extensions["code"] != "FORBIDDEN" {
+			t.Errorf("expected FORBIDDEN, got %v %q", got.Extensions["code"], got.Message)
+		}
+	})
+
+	t.Run("Project history is for the project's manager", func(t *testing.T) {
+		srv := seed(t)
+		query := `{ project(id: 2) { history { operation } } }`
+		data := responseData(t, srv, &model.AuthUser{ID: 1, Role: model.RoleManager}, GraphQLRequest{Query: query})
+		if history := data["project"].(map[string]interface{})["history"].([]interface{}); len(history) != 1 {
+			t.Errorf("expected the project's update, got %v", history)
+		}
+
+		for _, user := range []*model.AuthUser{engineer, {ID: 5, Role: model.RoleManager}} {
+			if got := firstError(t, srv, user, query); got.Extensions["code"] != "FORBIDDEN" {
+				t.Errorf("expected FORBIDDEN for %+v, got %v %q", user, got.Extensions["code"], got.Message)
+			}
+		}
+		if got := firstError(t, srv, nil, query); got.Extensions["code"] != "UNAUTHENTICATED" {
+			t.Errorf("expected UNAUTHENTICATED, got %v %q", got.Extensions["code"], got.Message)
+		}
+	})
+
+	t.Run("Tickets list their own history", func(t *testing.T) {
+		data := responseData(t, seed(t), engineer, GraphQLRequest{Query: `{ ticket(id: 6) { history { operation before after } } }`})
+		history := data["ticket"].(map[string]interface{})["history"].([]interface{})
+		if len(history) != 2 || history[0].(map[string]interface{})["operation"] != "CREATE" || history[1].(map[string]interface{})["operation"] != "UPDATE" {
+			t.Errorf("expected the create then the update, got %v", history)
+		}
+	})
+}
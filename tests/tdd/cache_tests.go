@@ -0,0 +1,266 @@
+package tdd
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/JonJenson-MFIn/project-management-system-api/cache"
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/directives"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"github.com/vektah/gqlparser/v2/ast"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func employeesFieldContext(ctx context.Context, args map[string]interface{}) context.Context {
+	return graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+		Args:   args,
+		Field: graphql.CollectedField{Field: &ast.Field{
+			Name:       "employees",
+			Definition: &ast.FieldDefinition{Name: "employees", Type: ast.ListType(ast.NonNullNamedType("Employee", nil), nil)},
+		}},
+	})
+}
+
+func TestLRUBackend(t *testing.T) {
+	t.Run("Least recently used entry is evicted", func(t *testing.T) {
+		lru := cache.NewLRU(2)
+		lru.Set("a", 1, time.Minute, nil)
+		lru.Set("b", 2, time.Minute, nil)
+		lru.Get("a")
+		lru.Set("c", 3, time.Minute, nil)
+
+		if _, ok := lru.Get("b"); ok {
+			t.Errorf("expected b to be evicted")
+		}
+		if _, ok := lru.Get("a"); !ok {
+			t.Errorf("expected a to survive")
+		}
+	})
+
+	t.Run("Expired entry is not returned", func(t *testing.T) {
+		lru := cache.NewLRU(2)
+		lru.Set("a", 1, -time.Second, nil)
+
+		if _, ok := lru.Get("a"); ok {
+			t.Errorf("expected a to be expired")
+		}
+	})
+
+	t.Run("Invalidating a tag drops only tagged entries", func(t *testing.T) {
+		lru := cache.NewLRU(10)
+		lru.Set("employees", 1, time.Minute, []string{"employees"})
+		lru.Set("teams", 2, time.Minute, []string{"teams", "project_teams"})
+		lru.InvalidateTags("project_teams")
+
+		if _, ok := lru.Get("teams"); ok {
+			t.Errorf("expected teams to be invalidated")
+		}
+		if _, ok := lru.Get("employees"); !ok {
+			t.Errorf("expected employees to survive")
+		}
+	})
+}
+
+func TestCacheDirective(t *testing.T) {
+	tests := []struct {
+		name      string
+		first     *model.AuthUser
+		second    *model.AuthUser
+		args      [2]map[string]interface{}
+		wantCalls int
+	}{
+		{"Same role and arguments hit the cache", &model.AuthUser{ID: 1, Role: model.RoleAdmin}, &model.AuthUser{ID: 2, Role: model.RoleAdmin}, [2]map[string]interface{}{{"id": 1}, {"id": 1}}, 1},
+		{"Different roles are cached separately", &model.AuthUser{ID: 1, Role: model.RoleAdmin}, &model.AuthUser{ID: 4, Role: model.RoleEmployee}, [2]map[string]interface{}{{"id": 1}, {"id": 1}}, 2},
+		{"Different arguments are cached separately", nil, nil, [2]map[string]interface{}{{"id": 1}, {"id": 2}}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responseCache := directives.NewResponseCache(cache.NewLRU(10))
+
+			calls := 0
+			next := func(ctx context.Context) (interface{}, error) {
+				calls++
+				return calls, nil
+			}
+
+			for i, user := range []*model.AuthUser{tt.first, tt.second} {
+				ctx := employeesFieldContext(context.Background(), tt.args[i])
+				if user != nil {
+					ctx = middleware.WithUser(ctx, user)
+				}
+				if _, err := responseCache.Directive(ctx, nil, next, 30); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if calls != tt.wantCalls {
+				t.Errorf("resolver ran %d times, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCacheInvalidationOnWrite(t *testing.T) {
+	// DryRun builds statements without a database, which is enough to fire the callbacks
+	database, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to open dry-run database: %v", err)
+	}
+
+	backend := cache.NewLRU(10)
+	if err := cache.RegisterInvalidation(database, backend); err != nil {
+		t.Fatalf("failed to register invalidation: %v", err)
+	}
+
+	backend.Set("employees", 1, time.Minute, []string{"employees"})
+	backend.Set("teams", 2, time.Minute, []string{"teams"})
+
+	database.Create(&db.Employee{Name: "New", Email: "new@example.com", Role: db.RoleEmployeeDB})
+
+	if _, ok := backend.Get("employees"); ok {
+		t.Errorf("expected employees entry to be invalidated by the insert")
+	}
+	if _, ok := backend.Get("teams"); !ok {
+		t.Errorf("expected teams entry to survive an employees insert")
+	}
+}
+
+// committingPool lets a dry-run database open transactions and reports their commits
+type committingPool struct {
+	gorm.ConnPool
+	onCommit func()
+}
+
+func (p *committingPool) BeginTx(context.Context, *sql.TxOptions) (gorm.ConnPool, error) {
+	return &committingTx{p}, nil
+}
+
+type committingTx struct {
+	pool *committingPool
+}
+
+func (t *committingTx) Commit() error {
+	t.pool.onCommit()
+	return nil
+}
+
+func (t *committingTx) Rollback() error {
+	return nil
+}
+
+func (t *committingTx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return t.pool.ExecContext(ctx, query, args...)
+}
+
+func (t *committingTx) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return t.pool.PrepareContext(ctx, query)
+}
+
+func (t *committingTx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return t.pool.QueryContext(ctx, query, args...)
+}
+
+func (t *committingTx) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return t.pool.QueryRowContext(ctx, query, args...)
+}
+
+func TestCacheInvalidationAfterCommit(t *testing.T) {
+	setup := func(t *testing.T) (*gorm.DB, *cache.LRU, *committingPool) {
+		t.Helper()
+		pool := &committingPool{onCommit: func() {}}
+		database, err := gorm.Open(postgres.New(postgres.Config{Conn: pool}), &gorm.Config{
+			DryRun:               true,
+			DisableAutomaticPing: true,
+		})
+		if err != nil {
+			t.Fatalf("failed to open dry-run database: %v", err)
+		}
+		backend := cache.NewLRU(10)
+		if err := cache.RegisterInvalidation(database, backend); err != nil {
+			t.Fatalf("failed to register invalidation: %v", err)
+		}
+		backend.Set("employees", 1, time.Minute, []string{"employees"})
+		backend.Set("teams", 2, time.Minute, []string{"teams"})
+		return database, backend, pool
+	}
+	cached := func(backend *cache.LRU, key string) bool {
+		_, ok := backend.Get(key)
+		return ok
+	}
+
+	t.Run("A single write is invalidated once it commits", func(t *testing.T) {
+		database, backend, pool := setup(t)
+		committed := false
+		pool.onCommit = func() {
+			committed = true
+			if !cached(backend, "employees") {
+				t.Errorf("expected employees still cached while the write commits")
+			}
+		}
+
+		database.Create(&db.Employee{Name: "New", Email: "new@example.com", Role: db.RoleEmployeeDB})
+		if !committed || cached(backend, "employees") {
+			t.Errorf("expected employees invalidated after the commit, committed=%v", committed)
+		}
+	})
+
+	t.Run("A transaction invalidates its tables once it commits", func(t *testing.T) {
+		database, backend, pool := setup(t)
+		committed := false
+		pool.onCommit = func() { committed = true }
+
+		err := cache.Transaction(context.Background(), database, func(tx *gorm.DB) error {
+			if err := tx.Create(&db.Employee{Name: "New", Email: "new@example.com", Role: db.RoleEmployeeDB}).Error; err != nil {
+				return err
+			}
+			cache.Invalidate(tx, "teams")
+			if !cached(backend, "employees") || !cached(backend, "teams") {
+				t.Errorf("expected nothing invalidated before the commit")
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("transaction failed: %v", err)
+		}
+		if !committed || cached(backend, "employees") || cached(backend, "teams") {
+			t.Errorf("expected both tables invalidated after the commit, committed=%v", committed)
+		}
+	})
+
+	t.Run("A rolled back transaction keeps the cache", func(t *testing.T) {
+		database, backend, _ := setup(t)
+		rollback := errors.New("rollback")
+
+		err := cache.Transaction(context.Background(), database, func(tx *gorm.DB) error {
+			tx.Create(&db.Employee{Name: "New", Email: "new@example.com", Role: db.RoleEmployeeDB})
+			return rollback
+		})
+		if !errors.Is(err, rollback) {
+			t.Fatalf("expected the rollback error, got %v", err)
+		}
+		if !cached(backend, "employees") {
+			t.Errorf("expected employees still cached after a rollback")
+		}
+	})
+
+	t.Run("Tables written outside the callbacks are invalidated explicitly", func(t *testing.T) {
+		database, backend, _ := setup(t)
+		cache.Invalidate(database, "teams")
+		if cached(backend, "teams") || !cached(backend, "employees") {
+			t.Errorf("expected only teams invalidated")
+		}
+	})
+}
@@ -0,0 +1,125 @@
+package tdd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/JonJenson-MFIn/project-management-system-api/repository"
+)
+
+func TestComments(t *testing.T) {
+	ctx := context.Background()
+	admin := &model.AuthUser{ID: 1, Role: model.RoleAdmin}
+	engineer := &model.AuthUser{ID: 3, Role: model.RoleEmployee}
+
+	// comment posts body on ticket 6 as user, or as a reply to parentID when it is set
+	comment := func(t *testing.T, srv http.Handler, user *model.AuthUser, parentID int, body string) int {
+		t.Helper()
+		target := "ticketID: 6"
+		if parentID != 0 {
+			target = fmt.Sprintf("parentID: %d", parentID)
+		}
+		data := responseData(t, srv, user, GraphQLRequest{Query: fmt.Sprintf(`mutation { addComment(input: { %s, body: %q }) { id } }`, target, body)})
+		return int(data["addComment"].(map[string]interface{})["id"].(float64))
+	}
+
+	t.Run("Replies thread under the comment they answer", func(t *testing.T) {
+		captureLogs(t)
+		srv := presentingServer(seedMemory(t), true)
+		parent := comment(t, srv, engineer, 0, "Seeing this on **staging** too")
+		comment(t, srv, admin, parent, "Fixed in the next deploy")
+
+		data := responseData(t, srv, engineer, GraphQLRequest{Query: `{ ticket(id: 6) { comments { totalCount edges { node {
+			body author { name } replies { ticketID body author { name } }
+		} } } } }`})
+		comments := data["ticket"].(map[string]interface{})["comments"].(map[string]interface{})
+		edges := comments["edges"].([]interface{})
+		if comments["totalCount"] != float64(1) || len(edges) != 1 {
+			t.Fatalf("expected one top-level comment, got %v", comments)
+		}
+		node := edges[0].(map[string]interface{})["node"].(map[string]interface{})
+		replies := node["replies"].([]interface{})
+		if node["body"] != "Seeing this on **staging** too" || node["author"].(map[string]interface{})["name"] != "Eddie Engineer" || len(replies) != 1 {
+			t.Fatalf("expected Eddie's comment with one reply, got %v", node)
+		}
+		if reply := replies[0].(map[string]interface{}); reply["ticketID"] != float64(6) || reply["author"].(map[string]interface{})["name"] != "Maria Manager" {
+			t.Errorf("expected Maria's reply on the same ticket, got %v", reply)
+		}
+	})
+
+	t.Run("A comment needs exactly one target", func(t *testing.T) {
+		srv := presentingServer(seedMemory(t), true)
+		for _, input := range []string{`body: "Orphan"`, `ticketID: 6, taskID: 8, body: "Both"`, `ticketID: 6, body: "   "`} {
+			got := firstError(t, srv, engineer, fmt.Sprintf(`mutation { addComment(input: { %s }) { id } }`, input))
+			if got.Extensions["code"] != "VALIDATION_FAILED" {
+				t.Errorf("expected VALIDATION_FAILED for %s, got %v %q", input, got.Extensions["code"], got.Message)
+			}
+		}
+	})
+
+	t.Run("Work the caller cannot see takes no comments", func(t *testing.T) {
+		got := firstError(t, presentingServer(seedMemory(t), true), engineer, `mutation { addComment(input: { ticketID: 7, body: "Hello" }) { id } }`)
+		if got.Extensions["code"] != "NOT_FOUND" {
+			t.Errorf("expected NOT_FOUND, got %v %q", got.Extensions["code"], got.Message)
+		}
+	})
+
+	t.Run("Edits keep the earlier body", func(t *testing.T) {
+		captureLogs(t)
+		srv := presentingServer(seedMemory(t), true)
+		id := comment(t, srv, engineer, 0, "Frist")
+
+		data := responseData(t, srv, engineer, GraphQLRequest{Query: fmt.Sprintf(`mutation { editComment(id: %d, body: "First") { body editedAt revisions { body } } }`, id)})
+		edited := data["editComment"].(map[string]interface{})
+		revisions := edited["revisions"].([]interface{})
+		if edited["body"] != "First" || edited["editedAt"] == nil || len(revisions) != 1 || revisions[0].(map[string]interface{})["body"] != "Frist" {
+			t.Errorf("expected the new body with the old one as a revision, got %v", edited)
+		}
+	})
+
+	t.Run("Only the author edits", func(t *testing.T) {
+		captureLogs(t)
+		srv := presentingServer(seedMemory(t), true)
+		id := comment(t, srv, engineer, 0, "Mine")
+
+		got := firstError(t, srv, admin, fmt.Sprintf(`mutation { editComment(id: %d, body: "Yours") { id } }`, id))
+		if got.Extensions["code"] != "FORBIDDEN" {
+			t.Errorf("expected FORBIDDEN, got %v %q", got.Extensions["code"], got.Message)
+		}
+	})
+
+	t.Run("Deleting a comment takes its replies along", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		srv := presentingServer(repos, true)
+		parent := comment(t, srv, engineer, 0, "Question")
+		reply := comment(t, srv, admin, parent, "Answer")
+
+		responseData(t, srv, admin, GraphQLRequest{Query: fmt.Sprintf(`mutation { deleteComment(id: %d) }`, parent)})
+		if _, err := repos.Comments.Get(ctx, reply); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("expected the reply deleted with its parent, got %v", err)
+		}
+
+		data := responseData(t, srv, admin, GraphQLRequest{Query: `mutation { purgeTrash(olderThanDays: 0) { comments } }`})
+		if purged := data["purgeTrash"].(map[string]interface{}); purged["comments"] != float64(2) {
+			t.Errorf("expected the thread purged, got %v", purged)
+		}
+	})
+
+	t.Run("Purging a ticket removes its comments", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		srv := presentingServer(repos, true)
+		id := comment(t, srv, engineer, 0, "Soon gone")
+
+		responseData(t, srv, admin, GraphQLRequest{Query: `mutation { deleteTicket(id: 6) }`})
+		responseData(t, srv, admin, GraphQLRequest{Query: `mutation { purgeTrash(olderThanDays: 0) { tickets } }`})
+		if _, err := repos.Comments.Get(ctx, id); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("expected the comment gone with its ticket, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,145 @@
+package tdd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/config"
+)
+
+func envFrom(vars map[string]string) func(string) string {
+	return func(name string) string { return vars[name] }
+}
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	required := map[string]string{"DATABASE_URL": "postgres://localhost/pms"}
+
+	t.Run("Defaults apply when nothing is set", func(t *testing.T) {
+		cfg, _, err := config.Load(nil, envFrom(required))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cfg.Server.Port != 8080 || cfg.Database.MaxOpenConns != 10 || cfg.Auth.AccessTTL != 15*time.Minute {
+			t.Errorf("unexpected defaults %+v", cfg)
+		}
+	})
+
+	t.Run("Missing DATABASE_URL fails fast", func(t *testing.T) {
+		_, _, err := config.Load(nil, envFrom(nil))
+		if err == nil || !strings.Contains(err.Error(), "DATABASE_URL") {
+			t.Errorf("expected a DATABASE_URL error, got %v", err)
+		}
+	})
+
+	t.Run("File, env and flags override in that order", func(t *testing.T) {
+		path := writeConfigFile(t, `
+database:
+  url: postgres://file/pms
+  max_open_conns: 20
+server:
+  port: 9000
+  cors_allowed_origins: ["https://file.example.com"]
+auth:
+  access_ttl: 5m
+`)
+		env := envFrom(map[string]string{
+			"CONFIG_FILE":          path,
+			"PORT":                 "9100",
+			"CORS_ALLOWED_ORIGINS": "https://a.example.com, https://b.example.com",
+		})
+		cfg, _, err := config.Load([]string{"-port", "9200", "-trust-proxy-headers"}, env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cfg.Database.URL != "postgres://file/pms" || cfg.Database.MaxOpenConns != 20 || cfg.Auth.AccessTTL != 5*time.Minute {
+			t.Errorf("expected file values, got %+v", cfg)
+		}
+		if len(cfg.Server.CORSAllowedOrigins) != 2 || cfg.Server.CORSAllowedOrigins[1] != "https://b.example.com" {
+			t.Errorf("expected env origins, got %v", cfg.Server.CORSAllowedOrigins)
+		}
+		if cfg.Server.Port != 9200 || !cfg.Server.TrustProxyHeaders {
+			t.Errorf("expected flag values, got %+v", cfg.Server)
+		}
+	})
+
+	t.Run("Config flag wins over CONFIG_FILE", func(t *testing.T) {
+		envPath := writeConfigFile(t, "server:\n  port: 1111\n")
+		flagPath := writeConfigFile(t, "server:\n  port: 2222\n")
+		env := map[string]string{"DATABASE_URL": "postgres://localhost/pms", "CONFIG_FILE": envPath}
+		cfg, _, err := config.Load([]string{"-config", flagPath}, envFrom(env))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cfg.Server.Port != 2222 {
+			t.Errorf("expected port from the flag's file, got %d", cfg.Server.Port)
+		}
+	})
+
+	t.Run("Arguments after the flags are returned", func(t *testing.T) {
+		_, args, err := config.Load([]string{"-port", "9000", "migrate", "to", "1"}, envFrom(required))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(args, " ") != "migrate to 1" {
+			t.Errorf("unexpected remaining args %v", args)
+		}
+	})
+
+	t.Run("Unknown file keys are rejected", func(t *testing.T) {
+		env := map[string]string{"DATABASE_URL": "postgres://localhost/pms", "CONFIG_FILE": writeConfigFile(t, "server:\n  prot: 9000\n")}
+		if _, _, err := config.Load(nil, envFrom(env)); err == nil {
+			t.Error("expected an unknown key error")
+		}
+	})
+
+	t.Run("Secrets cannot be passed as flags", func(t *testing.T) {
+		if _, _, err := config.Load([]string{"-database-url", "postgres://x"}, envFrom(nil)); err == nil {
+			t.Error("expected -database-url to be undefined")
+		}
+	})
+
+	invalid := []struct {
+		name string
+		env  map[string]string
+	}{
+		{"Malformed duration", map[string]string{"JWT_ACCESS_TTL": "soon"}},
+		{"Unknown environment", map[string]string{"APP_ENV": "staging"}},
+		{"Port out of range", map[string]string{"PORT": "70000"}},
+		{"Zero shutdown timeout", map[string]string{"SHUTDOWN_TIMEOUT": "0s"}},
+		{"Unknown trace exporter", map[string]string{"TRACING_EXPORTER": "jaeger"}},
+		{"Sample ratio above 1", map[string]string{"TRACING_SAMPLE_RATIO": "1.5"}},
+		{"Unknown log level", map[string]string{"LOG_LEVEL": "verbose"}},
+		{"Negative slow query threshold", map[string]string{"DB_SLOW_QUERY_THRESHOLD": "-1s"}},
+		{"Negative pool size", map[string]string{"DB_MAX_IDLE_CONNS": "-1"}},
+		{"Bcrypt cost out of range", map[string]string{"BCRYPT_COST": "2"}},
+		{"Password length above bcrypt's limit", map[string]string{"PASSWORD_MIN_LENGTH": "100"}},
+		{"CORS origin with a path", map[string]string{"CORS_ALLOWED_ORIGINS": "https://app.example.com/login"}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.env["DATABASE_URL"] = "postgres://localhost/pms"
+			if _, _, err := config.Load(nil, envFrom(tt.env)); err == nil {
+				t.Error("expected a validation error")
+			}
+		})
+	}
+
+	t.Run("Every problem is reported at once", func(t *testing.T) {
+		_, _, err := config.Load(nil, envFrom(map[string]string{"PORT": "0"}))
+		if err == nil || !strings.Contains(err.Error(), "DATABASE_URL") || !strings.Contains(err.Error(), "port") {
+			t.Errorf("expected both problems, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package tdd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/JonJenson-MFIn/project-management-system-api/directives"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/generated"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// schemaOverride swaps the schema an extension validates against
+type schemaOverride struct {
+	graphql.ExecutableSchema
+	schema *ast.Schema
+}
+
+func (s schemaOverride) Schema() *ast.Schema {
+	return s.schema
+}
+
+func TestConstraints(t *testing.T) {
+	admin := &model.AuthUser{ID: 1, Role: model.RoleAdmin}
+	tests := []struct {
+		name     string
+		request  GraphQLRequest
+		fields   []string
+		messages []string
+	}{
+		{
+			name:     "Every offending field is reported at once",
+			request:  GraphQLRequest{Query: `mutation { addEmployee(input: { name: "  ", role: EMPLOYEE, email: "not-an-email", password: "Passw0rd!" }) { id } }`},
+			fields:   []string{"input.name", "input.email"},
+			messages: []string{"input.name must not be blank", "input.email must be a valid email address"},
+		},
+		{
+			name: "Variables are checked like literals",
+			request: GraphQLRequest{
+				Query:     `mutation($input: TicketInput!) { addTicket(input: $input) { id } }`,
+				Variables: map[string]interface{}{"input": map[string]interface{}{"title": strings.Repeat("x", 201), "projectID": 0, "status": "NOT_STARTED"}},
+			},
+			fields:   []string{"input.title", "input.projectID"},
+			messages: []string{"input.title must be at most 200 characters", "input.projectID must be at least 1"},
+		},
+		{
+			name:     "Field arguments are checked",
+			request:  GraphQLRequest{Query: `mutation { addNotification(message: "", employeeID: 3) { id } }`},
+			fields:   []string{"message"},
+			messages: []string{"message must not be blank"},
+		},
+		{
+			name:     "List elements are checked one by one",
+			request:  GraphQLRequest{Query: `mutation { addProject(input: { name: "Gemini", teamIDs: [4, 0] }) { id } }`},
+			fields:   []string{"input.teamIDs[1]"},
+			messages: []string{"input.teamIDs[1] must be at least 1"},
+		},
+		{
+			name:     "Project role must be a known one",
+			request:  GraphQLRequest{Query: `mutation { addProjectEmployee(input: { projectID: 2, employeeID: 5, role: "BOSS" }) { role } }`},
+			fields:   []string{"input.role"},
+			messages: []string{"input.role must be one of MEMBER, LEAD"},
+		},
+		{
+			name:     "Due date must be a calendar date",
+			request:  GraphQLRequest{Query: `mutation { addTask(input: { title: "Write docs", dueDate: "2024-13-01", status: NOT_STARTED }) { id } }`},
+			fields:   []string{"input.dueDate"},
+			messages: []string{"input.dueDate must be a date in YYYY-MM-DD format"},
+		},
+		{
+			name:    "Valid input reaches the resolver",
+			request: GraphQLRequest{Query: `mutation { addProjectEmployee(input: { projectID: 2, employeeID: 5, role: "LEAD" }) { role } }`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureLogs(t)
+			errs := responseErrors(t, presentingServer(seedMemory(t), true), admin, tt.request)
+
+			if len(errs) != len(tt.fields) {
+				t.Fatalf("expected %d errors, got %+v", len(tt.fields), errs)
+			}
+			for i, err := range errs {
+				if err.Extensions["code"] != "VALIDATION_FAILED" || err.Extensions["field"] != tt.fields[i] || err.Message != tt.messages[i] {
+					t.Errorf("expected VALIDATION_FAILED on %s %q, got %v %q", tt.fields[i], tt.messages[i], err.Extensions, err.Message)
+				}
+			}
+		})
+	}
+
+	t.Run("Rejected input never reaches the repository", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		responseErrors(t, presentingServer(repos, true), admin, GraphQLRequest{
+			Query: `mutation { addEmployee(input: { name: "", role: EMPLOYEE, email: "new@example.com", password: "Passw0rd!" }) { id } }`,
+		})
+
+		if _, err := repos.Employees.GetByEmail(context.Background(), "new@example.com"); err == nil {
+			t.Error("employee was created despite the violation")
+		}
+	})
+
+	t.Run("Unknown formats fail at startup", func(t *testing.T) {
+		schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+			directive @constraint(minLength: Int, maxLength: Int, min: Int, format: String, oneOf: [String!]) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+			type Query { hello(name: String @constraint(format: "uuid")): String }
+		`})
+		executable := schemaOverride{generated.NewExecutableSchema(generated.Config{}), schema}
+
+		err := (&directives.Constraints{}).Validate(executable)
+		if err == nil || !strings.Contains(err.Error(), `unknown format "uuid"`) {
+			t.Errorf("expected an unknown format error, got %v", err)
+		}
+	})
+}
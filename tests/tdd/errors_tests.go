@@ -0,0 +1,192 @@
+package tdd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/auth"
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"github.com/JonJenson-MFIn/project-management-system-api/repository"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// panickingEmployees panics on every lookup, like a nil dereference in a repository
+type panickingEmployees struct {
+	repository.EmployeeRepository
+}
+
+func (panickingEmployees) Get(context.Context, int) (*db.Employee, error) {
+	panic("nil map")
+}
+
+type presentedError struct {
+	Message    string                 `json:"message"`
+	Extensions map[string]interface{} `json:"extensions"`
+}
+
+// responseErrors sends request as user and returns the errors in the response
+func responseErrors(t *testing.T, srv http.Handler, user *model.AuthUser, request GraphQLRequest) []presentedError {
+	t.Helper()
+	body, _ := json.Marshal(request)
+	req := httptest.NewRequest("POST", "/query", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Request-ID", "req-1")
+	req = req.WithContext(middleware.WithUser(req.Context(), user))
+	rec := httptest.NewRecorder()
+	middleware.RequestID(srv).ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got status %d", rec.Code)
+	}
+
+	var resp struct {
+		Errors []presentedError `json:"errors"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	return resp.Errors
+}
+
+// firstError runs query as user and returns the only error in the response
+func firstError(t *testing.T, srv http.Handler, user *model.AuthUser, query string) presentedError {
+	t.Helper()
+	errs := responseErrors(t, srv, user, GraphQLRequest{Query: query})
+	if len(errs) != 1 {
+		t.Fatalf("expected one error, got %+v", errs)
+	}
+	return errs[0]
+}
+
+func TestErrorCodes(t *testing.T) {
+	admin := &model.AuthUser{ID: 1, Role: model.RoleAdmin}
+	employee := &model.AuthUser{ID: 3, Role: model.RoleEmployee}
+	failing := func(repos *repository.Repositories) { repos.Employees = failingEmployees{repos.Employees} }
+	panicking := func(repos *repository.Repositories) { repos.Employees = panickingEmployees{repos.Employees} }
+
+	tests := []struct {
+		name     string
+		sabotage func(repos *repository.Repositories)
+		user     *model.AuthUser
+		query    string
+		code     string
+		message  string
+	}{
+		{name: "Missing row", query: `{ employee(id: 999) { name } }`, code: "NOT_FOUND", message: "employee not found"},
+		{name: "Role below the directive", user: employee, query: `mutation { deleteEmployee(id: 5) }`, code: "FORBIDDEN", message: "forbidden: requires ADMIN or higher, got EMPLOYEE"},
+		{name: "Anonymous caller", query: `mutation { signOut }`, code: "UNAUTHENTICATED", message: "unauthenticated"},
+		{name: "Unknown email at sign in", query: `{ signIn(email: "nobody@example.com", password: "x") { accessToken } }`, code: "UNAUTHENTICATED", message: "invalid credentials"},
+		{name: "Page size out of range", query: `{ employees(first: -1) { edges { node { name } } } }`, code: "VALIDATION_FAILED", message: "failed to fetch employees: first must be between 0 and 100"},
+		{name: "Duplicate link", user: admin, query: `mutation { addProjectTeam(input: { projectID: 2, teamID: 4 }) { teamID } }`, code: "CONFLICT", message: "failed to add team to project: team 4 is already in project 2"},
+		{name: "Database failure", sabotage: failing, query: `{ employees { edges { node { name } } } }`, code: "INTERNAL", message: "failed to fetch employees"},
+		{name: "Resolver panic", sabotage: panicking, query: `{ employee(id: 1) { name } }`, code: "INTERNAL", message: "internal error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureLogs(t)
+			repos := seedMemory(t)
+			if tt.sabotage != nil {
+				tt.sabotage(repos)
+			}
+
+			got := firstError(t, presentingServer(repos, true), tt.user, tt.query)
+			if got.Extensions["code"] != tt.code || got.Message != tt.message {
+				t.Errorf("expected %s %q, got %v %q", tt.code, tt.message, got.Extensions["code"], got.Message)
+			}
+			if _, ok := got.Extensions["requestId"]; ok != (tt.code == "INTERNAL") {
+				t.Errorf("requestId should only accompany internal errors, got %v", got.Extensions)
+			}
+		})
+	}
+}
+
+func TestSignInFailures(t *testing.T) {
+	hashed, err := auth.NewPasswordHasher(bcrypt.MinCost, auth.PasswordPolicy{}).Hash("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		email    string
+		password string
+		reason   string
+	}{
+		{"Unknown email", "nobody@example.com", "correct horse", "unknown email"},
+		{"Wrong password", "dora@example.com", "battery staple", "wrong password"},
+		{"Deactivated account with the right password", "dora@example.com", "correct horse", "account deactivated"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs := captureLogs(t)
+			repos := seedMemory(t)
+			dora := &db.Employee{Name: "Dora Departed", Email: "dora@example.com", Password: hashed, Role: db.RoleEmployeeDB, Active: false}
+			if err := repos.Employees.Create(context.Background(), dora); err != nil {
+				t.Fatal(err)
+			}
+
+			got := firstError(t, presentingServer(repos, true), nil, fmt.Sprintf(`{ signIn(email: %q, password: %q) { accessToken } }`, tt.email, tt.password))
+			if got.Extensions["code"] != "UNAUTHENTICATED" || got.Message != "invalid credentials" {
+				t.Errorf("expected the generic invalid credentials, got %v %q", got.Extensions["code"], got.Message)
+			}
+
+			for _, line := range logs() {
+				if line["msg"] == "sign in rejected" && line["reason"] == tt.reason {
+					return
+				}
+			}
+			t.Errorf("expected %q in the log, got %v", tt.reason, logs())
+		})
+	}
+}
+
+func TestErrorPresenter(t *testing.T) {
+	t.Run("Development shows the cause of internal errors", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		repos.Employees = failingEmployees{repos.Employees}
+
+		got := firstError(t, presentingServer(repos, false), nil, `{ employees { edges { node { name } } } }`)
+		if got.Extensions["code"] != "INTERNAL" || !strings.Contains(got.Message, "password authentication failed") {
+			t.Errorf("expected the cause in development, got %v %q", got.Extensions["code"], got.Message)
+		}
+	})
+
+	t.Run("Panics are logged with their stack", func(t *testing.T) {
+		logs := captureLogs(t)
+		repos := seedMemory(t)
+		repos.Employees = panickingEmployees{repos.Employees}
+
+		firstError(t, presentingServer(repos, true), nil, `{ employee(id: 1) { name } }`)
+
+		for _, line := range logs() {
+			stack, _ := line["stack"].(string)
+			if line["error"] == "panic: nil map" && line["request_id"] == "req-1" && strings.Contains(stack, "panickingEmployees") {
+				return
+			}
+		}
+		t.Errorf("expected the panic and its stack in the log, got %v", logs())
+	})
+
+	t.Run("Codes set by directives are kept", func(t *testing.T) {
+		captureLogs(t)
+		srv := presentingServer(seedMemory(t), true)
+
+		var got presentedError
+		for range 6 {
+			got = firstError(t, srv, nil, `{ signIn(email: "nobody@example.com", password: "x") { accessToken } }`)
+		}
+		if got.Extensions["code"] != "RATE_LIMITED" {
+			t.Errorf("expected RATE_LIMITED, got %v", got.Extensions)
+		}
+	})
+}
@@ -0,0 +1,114 @@
+package tdd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func dryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	database, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to open dry-run database: %v", err)
+	}
+	return database
+}
+
+func strPtr(v string) *string {
+	return &v
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
+
+func timePtr(v time.Time) *time.Time {
+	return &v
+}
+
+func TestFilterScopes(t *testing.T) {
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	status := model.StatusInProgress
+	priority := model.PriorityHigh
+	role := model.RoleTl
+	notificationType := model.NotificationTypeWarning
+
+	tests := []struct {
+		name  string
+		model interface{}
+		scope db.Scope
+		want  []string
+	}{
+		{"Employee name substring", &db.Employee{}, db.EmployeeFilterScope(&model.EmployeeFilter{Name: strPtr("ali")}), []string{"employees.name ILIKE '%ali%'"}},
+		{"Employee email substring", &db.Employee{}, db.EmployeeFilterScope(&model.EmployeeFilter{Email: strPtr("@corp")}), []string{"employees.email ILIKE '%@corp%'"}},
+		{"Employee role", &db.Employee{}, db.EmployeeFilterScope(&model.EmployeeFilter{Role: &role}), []string{"employees.role = 'TL'"}},
+		{"Employee active flag", &db.Employee{}, db.EmployeeFilterScope(&model.EmployeeFilter{Active: boolPtr(false)}), []string{"employees.active = false"}},
+		{"Employee assigned project", &db.Employee{}, db.EmployeeFilterScope(&model.EmployeeFilter{ProjectAssignedID: intPtr(7)}), []string{"employees.project_assigned_id = 7"}},
+		{"Employee created range", &db.Employee{}, db.EmployeeFilterScope(&model.EmployeeFilter{CreatedAtAfter: timePtr(day), CreatedAtBefore: timePtr(day.AddDate(0, 1, 0))}), []string{"employees.created_at >= '2024-03-01", "employees.created_at <= '2024-04-01"}},
+		{"Project name substring", &db.Project{}, db.ProjectFilterScope(&model.ProjectFilter{Name: strPtr("apollo")}), []string{"projects.name ILIKE '%apollo%'"}},
+		{"Project status", &db.Project{}, db.ProjectFilterScope(&model.ProjectFilter{Status: &status}), []string{"projects.status = 'IN_PROGRESS'"}},
+		{"Project manager", &db.Project{}, db.ProjectFilterScope(&model.ProjectFilter{ManagerID: intPtr(3)}), []string{"projects.manager_id = 3"}},
+		{"Project start date range", &db.Project{}, db.ProjectFilterScope(&model.ProjectFilter{StartDateAfter: timePtr(day), StartDateBefore: timePtr(day.AddDate(0, 0, 7))}), []string{"projects.start_date >= '2024-03-01", "projects.start_date <= '2024-03-08"}},
+		{"Project created range", &db.Project{}, db.ProjectFilterScope(&model.ProjectFilter{CreatedAtAfter: timePtr(day)}), []string{"projects.created_at >= '2024-03-01"}},
+		{"Task title substring", &db.Task{}, db.TaskFilterScope(&model.TaskFilter{Title: strPtr("login")}), []string{"tasks.title ILIKE '%login%'"}},
+		{"Task status", &db.Task{}, db.TaskFilterScope(&model.TaskFilter{Status: &status}), []string{"tasks.status = 'IN_PROGRESS'"}},
+		{"Task priority", &db.Task{}, db.TaskFilterScope(&model.TaskFilter{Priority: &priority}), []string{"tasks.priority = 'HIGH'"}},
+		{"Task assignee", &db.Task{}, db.TaskFilterScope(&model.TaskFilter{AssignedToID: intPtr(4)}), []string{"tasks.assigned_to_id = 4"}},
+		{"Task project", &db.Task{}, db.TaskFilterScope(&model.TaskFilter{ProjectID: intPtr(2)}), []string{"tasks.project_id = 2"}},
+		{"Task due date range", &db.Task{}, db.TaskFilterScope(&model.TaskFilter{DueDateAfter: strPtr("2024-03-01"), DueDateBefore: strPtr("2024-03-31")}), []string{"tasks.due_date >= '2024-03-01", "tasks.due_date <= '2024-03-31"}},
+		{"Task created range", &db.Task{}, db.TaskFilterScope(&model.TaskFilter{CreatedAtBefore: timePtr(day)}), []string{"tasks.created_at <= '2024-03-01"}},
+		{"Ticket title substring", &db.Ticket{}, db.TicketFilterScope(&model.TicketFilter{Title: strPtr("crash")}), []string{"tickets.title ILIKE '%crash%'"}},
+		{"Ticket status", &db.Ticket{}, db.TicketFilterScope(&model.TicketFilter{Status: &status}), []string{"tickets.status = 'IN_PROGRESS'"}},
+		{"Ticket priority", &db.Ticket{}, db.TicketFilterScope(&model.TicketFilter{Priority: &priority}), []string{"tickets.priority = 'HIGH'"}},
+		{"Ticket project", &db.Ticket{}, db.TicketFilterScope(&model.TicketFilter{ProjectID: intPtr(2)}), []string{"tickets.project_id = 2"}},
+		{"Ticket assignee", &db.Ticket{}, db.TicketFilterScope(&model.TicketFilter{AssignedToID: intPtr(4)}), []string{"tickets.assigned_to_id = 4"}},
+		{"Ticket created range", &db.Ticket{}, db.TicketFilterScope(&model.TicketFilter{CreatedAtAfter: timePtr(day)}), []string{"tickets.created_at >= '2024-03-01"}},
+		{"Team name substring", &db.Team{}, db.TeamFilterScope(&model.TeamFilter{Name: strPtr("core")}), []string{"teams.name ILIKE '%core%'"}},
+		{"Team leader", &db.Team{}, db.TeamFilterScope(&model.TeamFilter{TeamLeaderID: intPtr(5)}), []string{"teams.team_leader_id = 5"}},
+		{"Team created range", &db.Team{}, db.TeamFilterScope(&model.TeamFilter{CreatedAtBefore: timePtr(day)}), []string{"teams.created_at <= '2024-03-01"}},
+		{"Notification type", &db.Notification{}, db.NotificationFilterScope(&model.NotificationFilter{Type: &notificationType}), []string{"notifications.type = 'WARNING'"}},
+		{"Notification read flag", &db.Notification{}, db.NotificationFilterScope(&model.NotificationFilter{Read: boolPtr(true)}), []string{"notifications.read = true"}},
+		{"Notification created range", &db.Notification{}, db.NotificationFilterScope(&model.NotificationFilter{CreatedAtAfter: timePtr(day)}), []string{"notifications.created_at >= '2024-03-01"}},
+		{"Wildcards in substrings are escaped", &db.Employee{}, db.EmployeeFilterScope(&model.EmployeeFilter{Name: strPtr("100%_")}), []string{`employees.name ILIKE '%100\%\_%'`}},
+	}
+
+	database := dryRunDB(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql := database.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return tx.Model(tt.model).Scopes(tt.scope).Find(&[]map[string]interface{}{})
+			})
+			for _, fragment := range tt.want {
+				if !strings.Contains(sql, fragment) {
+					t.Errorf("expected %q in %s", fragment, sql)
+				}
+			}
+		})
+	}
+
+	t.Run("Nil filter adds no conditions", func(t *testing.T) {
+		sql := database.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return tx.Model(&db.Task{}).Scopes(db.TaskFilterScope(nil)).Find(&[]db.Task{})
+		})
+		if strings.Contains(sql, "tasks.") {
+			t.Errorf("expected no filter conditions, got %s", sql)
+		}
+	})
+
+	t.Run("Malformed due date is rejected", func(t *testing.T) {
+		err := database.Model(&db.Task{}).Scopes(db.TaskFilterScope(&model.TaskFilter{DueDateAfter: strPtr("03/01/2024")})).Find(&[]db.Task{}).Error
+		if err == nil || !strings.Contains(err.Error(), "invalid date") {
+			t.Errorf("expected invalid date error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package tdd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/health"
+)
+
+func probe(t *testing.T, handler http.Handler) (int, map[string]interface{}) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	return w.Code, body
+}
+
+func TestHealthEndpoints(t *testing.T) {
+	passing := health.Check{Name: "database", Run: func(context.Context) error { return nil }}
+
+	t.Run("Liveness is always ok", func(t *testing.T) {
+		if code, body := probe(t, health.Live()); code != http.StatusOK || body["status"] != "ok" {
+			t.Errorf("got %d %v", code, body)
+		}
+	})
+
+	t.Run("Ready when every check passes", func(t *testing.T) {
+		code, body := probe(t, health.Ready(time.Second, passing))
+		if code != http.StatusOK || body["checks"].(map[string]interface{})["database"] != "ok" {
+			t.Errorf("got %d %v", code, body)
+		}
+	})
+
+	t.Run("A failing check makes the service unavailable", func(t *testing.T) {
+		failing := health.Check{Name: "migrations", Run: func(context.Context) error { return errors.New("schema is behind") }}
+		code, body := probe(t, health.Ready(time.Second, passing, failing))
+		if code != http.StatusServiceUnavailable || body["status"] != "unavailable" {
+			t.Fatalf("got %d %v", code, body)
+		}
+		if body["checks"].(map[string]interface{})["migrations"] != "schema is behind" {
+			t.Errorf("expected the failure to be reported, got %v", body)
+		}
+	})
+
+	t.Run("Slow checks are cut off by the timeout", func(t *testing.T) {
+		slow := health.Check{Name: "database", Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}}
+		start := time.Now()
+		code, _ := probe(t, health.Ready(20*time.Millisecond, slow))
+		if code != http.StatusServiceUnavailable || time.Since(start) > time.Second {
+			t.Errorf("expected a quick 503, got %d after %s", code, time.Since(start))
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package tdd
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/loaders"
+	"github.com/JonJenson-MFIn/project-management-system-api/policy"
+	"github.com/JonJenson-MFIn/project-management-system-api/repository"
+)
+
+// loadAll calls Load for every key concurrently, as gqlgen does for sibling fields
+func loadAll[V any](loader *loaders.Loader[int, V], keys []int) ([]V, []error) {
+	values := make([]V, len(keys))
+	errs := make([]error, len(keys))
+
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			values[i], errs[i] = loader.Load(context.Background(), key)
+		}()
+	}
+	wg.Wait()
+	return values, errs
+}
+
+func TestLoader(t *testing.T) {
+	t.Run("Concurrent loads share one fetch", func(t *testing.T) {
+		var fetches atomic.Int32
+		loader := loaders.NewLoader(func(ctx context.Context, keys []int) (map[int]int, error) {
+			fetches.Add(1)
+			result := map[int]int{}
+			for _, key := range keys {
+				result[key] = key * 10
+			}
+			return result, nil
+		})
+
+		values, _ := loadAll(loader, []int{1, 2, 3, 4, 5})
+		if fetches.Load() != 1 {
+			t.Errorf("expected 1 fetch, got %d", fetches.Load())
+		}
+		if values[2] != 30 {
+			t.Errorf("expected 30 for key 3, got %d", values[2])
+		}
+	})
+
+	t.Run("Repeated keys are fetched once", func(t *testing.T) {
+		var fetched atomic.Int32
+		loader := loaders.NewLoader(func(ctx context.Context, keys []int) (map[int]int, error) {
+			fetched.Add(int32(len(keys)))
+			return map[int]int{}, nil
+		})
+
+		loadAll(loader, []int{7, 7, 7})
+		loader.Load(context.Background(), 7)
+		if fetched.Load() != 1 {
+			t.Errorf("expected key 7 to be fetched once, fetched %d keys", fetched.Load())
+		}
+	})
+
+	t.Run("Missing keys resolve to the zero value", func(t *testing.T) {
+		loader := loaders.NewLoader(func(ctx context.Context, keys []int) (map[int]*string, error) {
+			return map[int]*string{}, nil
+		})
+
+		value, err := loader.Load(context.Background(), 1)
+		if err != nil || value != nil {
+			t.Errorf("expected nil value and error, got %v, %v", value, err)
+		}
+	})
+
+	t.Run("Fetch errors reach every caller", func(t *testing.T) {
+		loader := loaders.NewLoader(func(ctx context.Context, keys []int) (map[int]int, error) {
+			return nil, errors.New("database down")
+		})
+
+		_, errs := loadAll(loader, []int{1, 2})
+		for _, err := range errs {
+			if err == nil || err.Error() != "database down" {
+				t.Errorf("expected fetch error, got %v", err)
+			}
+		}
+	})
+
+	t.Run("A panicking fetch fails every caller", func(t *testing.T) {
+		captureLogs(t)
+		loader := loaders.NewLoader(func(ctx context.Context, keys []int) (map[int]int, error) {
+			var missing map[int]*int
+			return map[int]int{keys[0]: *missing[keys[0]]}, nil
+		})
+
+		_, errs := loadAll(loader, []int{1, 2})
+		for _, err := range errs {
+			if err == nil || !strings.Contains(err.Error(), "loader fetch panicked") {
+				t.Errorf("expected the panic as an error, got %v", err)
+			}
+		}
+	})
+
+	t.Run("Large batches are split", func(t *testing.T) {
+		var fetches atomic.Int32
+		loader := loaders.NewLoader(func(ctx context.Context, keys []int) (map[int]int, error) {
+			fetches.Add(1)
+			return map[int]int{}, nil
+		})
+
+		keys := make([]int, loaders.DefaultMaxBatch+1)
+		for i := range keys {
+			keys[i] = i
+		}
+		loadAll(loader, keys)
+		if fetches.Load() != 2 {
+			t.Errorf("expected 2 fetches, got %d", fetches.Load())
+		}
+	})
+}
+
+func TestRelationshipLoaders(t *testing.T) {
+	database := dryRunDB(t)
+	queries := capturedQueries(t, database)
+	set := loaders.New(repository.NewPostgres(database, policy.NewEngine(database)))
+
+	// Ten projects resolving their manager and teams
+	ids := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	loadAll(set.EmployeeByID, ids)
+	loadAll(set.TeamsByProject, ids)
+
+	if len(*queries) != 2 {
+		t.Fatalf("expected 2 statements for 20 loads, got %d: %v", len(*queries), *queries)
+	}
+	if !strings.Contains((*queries)[0], "IN (") {
+		t.Errorf("expected a batched IN query, got %s", (*queries)[0])
+	}
+}
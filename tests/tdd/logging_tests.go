@@ -0,0 +1,255 @@
+package tdd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/JonJenson-MFIn/project-management-system-api/cache"
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/directives"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/generated"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/resolvers"
+	"github.com/JonJenson-MFIn/project-management-system-api/logging"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"github.com/JonJenson-MFIn/project-management-system-api/repository"
+)
+
+// captureLogs installs a debug JSON logger as the default for the test and returns its
+// decoded lines
+func captureLogs(t *testing.T) func() []map[string]interface{} {
+	t.Helper()
+	var buf bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(logging.New(&buf, slog.LevelDebug))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	return func() []map[string]interface{} {
+		var lines []map[string]interface{}
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			if line == "" {
+				continue
+			}
+			var entry map[string]interface{}
+			if err := json.Unmarshal([]byte(line), &entry); err != nil {
+				t.Fatalf("log line is not JSON: %q", line)
+			}
+			lines = append(lines, entry)
+		}
+		return lines
+	}
+}
+
+// failingEmployees fails every listing the way a broken database connection would
+type failingEmployees struct {
+	repository.EmployeeRepository
+}
+
+func (failingEmployees) List(context.Context, *model.EmployeeFilter, db.PageArgs) (*db.Page[db.Employee], error) {
+	return nil, errors.New(`pq: password authentication failed for user "admin"`)
+}
+
+// presentingServer serves repos with input constraints and the error presenter of
+// production, or of development when hideInternal is false
+func presentingServer(repos *repository.Repositories, hideInternal bool) http.Handler {
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+		Resolvers: &resolvers.Resolver{Repos: repos},
+		Directives: generated.DirectiveRoot{
+			Auth:      directives.AuthDirective,
+			Cache:     directives.NewResponseCache(cache.NewLRU(10)).Directive,
+			RateLimit: directives.NewRateLimiter().Directive,
+		},
+	}))
+	srv.SetErrorPresenter(resolvers.ErrorPresenter(hideInternal))
+	srv.SetRecoverFunc(resolvers.Recover)
+	srv.Use(&directives.Constraints{})
+	return srv
+}
+
+func TestRequestID(t *testing.T) {
+	generated := regexp.MustCompile(`^[0-9a-f]{32}$`)
+	tests := []struct {
+		name     string
+		incoming string
+		keep     bool
+	}{
+		{name: "Missing ID is generated", incoming: ""},
+		{name: "Caller's ID is kept", incoming: "req-42.a:b_c", keep: true},
+		{name: "ID with spaces is replaced", incoming: "forged id"},
+		{name: "Overlong ID is replaced", incoming: strings.Repeat("a", 129)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var seen string
+			handler := middleware.RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				seen = logging.RequestID(r.Context())
+			}))
+
+			req := httptest.NewRequest("GET", "/", nil)
+			if tt.incoming != "" {
+				req.Header.Set("X-Request-ID", tt.incoming)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Header().Get("X-Request-ID") != seen {
+				t.Errorf("response header %q does not match context ID %q", rec.Header().Get("X-Request-ID"), seen)
+			}
+			if tt.keep && seen != tt.incoming {
+				t.Errorf("expected caller's ID %q, got %q", tt.incoming, seen)
+			}
+			if !tt.keep && !generated.MatchString(seen) {
+				t.Errorf("expected a generated ID, got %q", seen)
+			}
+		})
+	}
+}
+
+func TestLogging(t *testing.T) {
+	t.Run("Access log carries the request ID and the employee authenticated downstream", func(t *testing.T) {
+		logs := captureLogs(t)
+		inner := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			middleware.WithUser(r.Context(), &model.AuthUser{ID: 7, Role: model.RoleEmployee})
+			w.WriteHeader(http.StatusTeapot)
+		})
+
+		req := httptest.NewRequest("GET", "/query", nil)
+		req.Header.Set("X-Request-ID", "abc-123")
+		middleware.RequestID(middleware.AccessLog(inner)).ServeHTTP(httptest.NewRecorder(), req)
+
+		lines := logs()
+		if len(lines) != 1 {
+			t.Fatalf("expected one access log line, got %v", lines)
+		}
+		line := lines[0]
+		if line["msg"] != "request" || line["request_id"] != "abc-123" || line["employee_id"] != float64(7) || line["status"] != float64(http.StatusTeapot) {
+			t.Errorf("unexpected access log line: %v", line)
+		}
+	})
+
+	t.Run("Employee can be set while the request logs from other goroutines", func(t *testing.T) {
+		var buf syncBuffer
+		logger := logging.New(&buf, slog.LevelInfo)
+		ctx := logging.WithRequestID(context.Background(), "shared-1")
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				logger.InfoContext(ctx, "working")
+			}()
+		}
+		logging.WithEmployeeID(ctx, 9)
+		wg.Wait()
+
+		logger.InfoContext(ctx, "done")
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		var last map[string]interface{}
+		if err := json.Unmarshal([]byte(lines[len(lines)-1]), &last); err != nil || last["employee_id"] != float64(9) {
+			t.Errorf("expected the employee on the last line, got %q", lines[len(lines)-1])
+		}
+	})
+
+	t.Run("SQL is logged with placeholders, never bound values", func(t *testing.T) {
+		var buf bytes.Buffer
+		database := dryRunDB(t)
+		database.Logger = logging.NewGormLogger(logging.New(&buf, slog.LevelDebug), time.Second)
+
+		ctx := logging.WithRequestID(context.Background(), "sql-1")
+		database.WithContext(ctx).Where("email = ?", "secret@example.com").Find(&[]db.Employee{})
+
+		var line map[string]interface{}
+		if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+			t.Fatalf("expected one JSON line, got %q", buf.String())
+		}
+		if line["request_id"] != "sql-1" || line["level"] != "DEBUG" {
+			t.Errorf("unexpected SQL log line: %v", line)
+		}
+		if sql, _ := line["sql"].(string); !strings.Contains(sql, "$1") || strings.Contains(buf.String(), "secret@example.com") {
+			t.Errorf("bound value leaked into the log: %s", buf.String())
+		}
+	})
+
+	t.Run("Internal errors are logged and hidden behind the request ID", func(t *testing.T) {
+		logs := captureLogs(t)
+		repos := seedMemory(t)
+		repos.Employees = failingEmployees{repos.Employees}
+		srv := presentingServer(repos, true)
+
+		body, _ := json.Marshal(GraphQLRequest{Query: `{ employees { edges { node { name } } } }`})
+		req := httptest.NewRequest("POST", "/query", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Request-ID", "req-500")
+		rec := httptest.NewRecorder()
+		middleware.RequestID(srv).ServeHTTP(rec, req)
+
+		if strings.Contains(rec.Body.String(), "password authentication") {
+			t.Fatalf("database error leaked to the client: %s", rec.Body.String())
+		}
+		var resp struct {
+			Errors []struct {
+				Message    string                 `json:"message"`
+				Extensions map[string]interface{} `json:"extensions"`
+			} `json:"errors"`
+		}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		if len(resp.Errors) != 1 || resp.Errors[0].Message != "failed to fetch employees" || resp.Errors[0].Extensions["requestId"] != "req-500" {
+			t.Errorf("unexpected errors: %+v", resp.Errors)
+		}
+
+		var logged bool
+		for _, line := range logs() {
+			if line["level"] == "ERROR" && line["request_id"] == "req-500" && strings.Contains(line["error"].(string), "password authentication") {
+				logged = true
+			}
+		}
+		if !logged {
+			t.Errorf("expected the cause to be logged with the request ID, got %v", logs())
+		}
+	})
+
+	t.Run("Client errors keep their detail", func(t *testing.T) {
+		srv := presentingServer(seedMemory(t), true)
+
+		body, _ := json.Marshal(GraphQLRequest{Query: `{ employees(first: -1) { edges { node { name } } } }`})
+		req := httptest.NewRequest("POST", "/query", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		middleware.RequestID(srv).ServeHTTP(rec, req)
+
+		if !strings.Contains(rec.Body.String(), "first") || strings.Contains(rec.Body.String(), "requestId") {
+			t.Errorf("expected the validation detail without a request ID, got %s", rec.Body.String())
+		}
+	})
+}
+
+// syncBuffer is a bytes.Buffer safe for loggers writing from several goroutines
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
@@ -0,0 +1,68 @@
+package tdd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/generated"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/resolvers"
+	"github.com/JonJenson-MFIn/project-management-system-api/metrics"
+)
+
+func TestMetrics(t *testing.T) {
+	telemetry := metrics.New()
+	sqlDB, err := dryRunDB(t).DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := telemetry.RegisterDB(sqlDB, "postgres"); err != nil {
+		t.Fatal(err)
+	}
+
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+		Resolvers: &resolvers.Resolver{Repos: seedMemory(t)},
+	}))
+	srv.Use(telemetry)
+
+	for _, query := range []string{
+		`query Roster { employees { edges { node { name } } } }`,
+		`query { employee(id: 1) { name } }`,
+		`query { nope }`,
+	} {
+		body, _ := json.Marshal(GraphQLRequest{Query: query})
+		req := httptest.NewRequest("POST", "/query", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		srv.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	w := httptest.NewRecorder()
+	telemetry.Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	raw, _ := io.ReadAll(w.Body)
+	scrape := string(raw)
+
+	tests := []struct {
+		name   string
+		want   string
+		absent bool
+	}{
+		{"Operation latency by name", `graphql_operation_duration_seconds_count{operation="Roster",type="query"} 1`, false},
+		{"Unnamed operations are grouped", `graphql_operation_duration_seconds_count{operation="anonymous",type="query"} 1`, false},
+		{"Resolver fields are timed", `graphql_field_duration_seconds_count{field="employees",object="Query"} 1`, false},
+		{"Plain struct fields are not timed", `field="name"`, true},
+		{"Errors are counted by code", `graphql_errors_total{code="GRAPHQL_VALIDATION_FAILED",operation=""} 1`, false},
+		{"Complexity is recorded", `graphql_operation_complexity_count{operation="Roster",type="query"} 1`, false},
+		{"Pool stats are exposed", `go_sql_open_connections{db_name="postgres"}`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if strings.Contains(scrape, tt.want) == tt.absent {
+				t.Errorf("expected %q present=%v in:\n%s", tt.want, !tt.absent, scrape)
+			}
+		})
+	}
+}
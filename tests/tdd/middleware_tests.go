@@ -3,12 +3,15 @@ package tdd
 import (
 	"bytes"
 	"encoding/json"
+	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/JonJenson-MFIn/project-management-system-api/graph/generated"
 	"github.com/JonJenson-MFIn/project-management-system-api/graph/resolvers"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"github.com/JonJenson-MFIn/project-management-system-api/repository"
 )
 
 func TestMiddlewareIntegration(t *testing.T) {
@@ -20,13 +23,13 @@ func TestMiddlewareIntegration(t *testing.T) {
 	}{
 		{
 			name:        "Valid JSON content type should work",
-			query:       `query { employees { id } }`,
+			query:       `query { employees { edges { node { id } } } }`,
 			contentType: "application/json",
 			wantErr:     false,
 		},
 		{
 			name:        "Invalid content type should fail",
-			query:       `query { employees { id } }`,
+			query:       `query { employees { edges { node { id } } } }`,
 			contentType: "text/plain",
 			wantErr:     true,
 		},
@@ -38,7 +41,7 @@ func TestMiddlewareIntegration(t *testing.T) {
 				handler.NewDefaultServer(
 					generated.NewExecutableSchema(
 						generated.Config{
-							Resolvers: &resolvers.Resolver{},
+							Resolvers: &resolvers.Resolver{Repos: repository.NewMemory().Repositories()},
 						},
 					),
 				),
@@ -58,3 +61,43 @@ func TestMiddlewareIntegration(t *testing.T) {
 		})
 	}
 }
+
+func TestCORS(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name       string
+		allowed    []string
+		method     string
+		origin     string
+		wantStatus int
+		wantOrigin string
+	}{
+		{"Allowed origin is echoed", []string{"https://app.example.com"}, "POST", "https://app.example.com", http.StatusOK, "https://app.example.com"},
+		{"Other origins get no header", []string{"https://app.example.com"}, "POST", "https://evil.example.com", http.StatusOK, ""},
+		{"Wildcard allows any origin", []string{"*"}, "POST", "https://evil.example.com", http.StatusOK, "https://evil.example.com"},
+		{"Preflight is answered without the handler", []string{"https://app.example.com"}, "OPTIONS", "https://app.example.com", http.StatusNoContent, "https://app.example.com"},
+		{"No origins disables CORS", nil, "POST", "https://app.example.com", http.StatusOK, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/query", nil)
+			req.Header.Set("Origin", tt.origin)
+			if tt.method == "OPTIONS" {
+				req.Header.Set("Access-Control-Request-Method", "POST")
+			}
+
+			w := httptest.NewRecorder()
+			middleware.CORS(tt.allowed)(next).ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("got status %d, want %d", w.Code, tt.wantStatus)
+			}
+			if got := w.Header().Get("Access-Control-Allow-Origin"); got != tt.wantOrigin {
+				t.Errorf("got allowed origin %q, want %q", got, tt.wantOrigin)
+			}
+		})
+	}
+}
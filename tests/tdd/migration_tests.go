@@ -0,0 +1,164 @@
+package tdd
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db/migrations"
+	"gorm.io/gorm"
+)
+
+func migrationFiles(names ...string) fstest.MapFS {
+	fsys := fstest.MapFS{}
+	for _, name := range names {
+		fsys[name] = &fstest.MapFile{Data: []byte("-- " + name)}
+	}
+	return fsys
+}
+
+func TestLoadMigrations(t *testing.T) {
+	t.Run("Embedded migrations load in version order", func(t *testing.T) {
+		loaded, err := migrations.Embedded()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, migration := range loaded {
+			if migration.Version != i+1 {
+				t.Errorf("expected contiguous versions from 1, got %d at position %d", migration.Version, i)
+			}
+			if migration.Up == "" || migration.Down == "" {
+				t.Errorf("migration %d is missing a direction", migration.Version)
+			}
+		}
+	})
+
+	t.Run("Steps in Go attach to a shipped migration", func(t *testing.T) {
+		migrator, err := migrations.New(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		noop := func(*gorm.DB) error { return nil }
+		if err := migrator.After(migrator.Latest(), noop); err != nil {
+			t.Errorf("expected the latest migration to take a step, got %v", err)
+		}
+		if err := migrator.After(migrator.Latest()+1, noop); err == nil {
+			t.Error("expected an unknown version to be rejected")
+		}
+	})
+
+	t.Run("Files are paired and sorted", func(t *testing.T) {
+		loaded, err := migrations.Load(migrationFiles(
+			"0010_later.up.sql", "0010_later.down.sql",
+			"0002_first.up.sql", "0002_first.down.sql",
+		))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(loaded) != 2 || loaded[0].Version != 2 || loaded[1].Name != "later" {
+			t.Errorf("unexpected migrations %+v", loaded)
+		}
+	})
+
+	tests := []struct {
+		name  string
+		files []string
+	}{
+		{"Missing down file is rejected", []string{"0001_init.up.sql"}},
+		{"Badly named file is rejected", []string{"init.sql"}},
+		{"Version with two names is rejected", []string{"0001_init.up.sql", "0001_other.down.sql"}},
+		{"Version zero is rejected", []string{"0000_init.up.sql", "0000_init.down.sql"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := migrations.Load(migrationFiles(tt.files...)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestMigrationPlan(t *testing.T) {
+	all, err := migrations.Load(migrationFiles(
+		"0001_a.up.sql", "0001_a.down.sql",
+		"0002_b.up.sql", "0002_b.down.sql",
+		"0003_c.up.sql", "0003_c.down.sql",
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	record := func(m migrations.Migration) migrations.Record {
+		return migrations.Record{Version: m.Version, Name: m.Name, Checksum: m.Checksum()}
+	}
+	describe := func(steps []migrations.Step) string {
+		s := ""
+		for _, step := range steps {
+			if step.Down {
+				s += "-"
+			} else {
+				s += "+"
+			}
+			s += step.Migration.Name
+		}
+		return s
+	}
+
+	tests := []struct {
+		name    string
+		applied []migrations.Record
+		target  int
+		want    string
+		wantErr error
+	}{
+		{"Fresh database applies everything in order", nil, 3, "+a+b+c", nil},
+		{"Up to date database has nothing to do", []migrations.Record{record(all[0]), record(all[1]), record(all[2])}, 3, "", nil},
+		{"Target 0 reverts newest first", []migrations.Record{record(all[0]), record(all[1])}, 0, "-b-a", nil},
+		{"Target in the middle reverts and fills gaps", []migrations.Record{record(all[0]), record(all[2])}, 2, "-c+b", nil},
+		{"Edited migration blocks every change", []migrations.Record{{Version: 1, Name: "a", Checksum: "stale"}}, 3, "", migrations.ErrChecksumMismatch},
+		{"Unknown applied version blocks every change", []migrations.Record{record(all[0]), {Version: 9, Name: "future"}}, 1, "", migrations.ErrUnknownVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			steps, err := migrations.Plan(all, tt.applied, tt.target)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got err %v, want %v", err, tt.wantErr)
+			}
+			if got := describe(steps); got != tt.want {
+				t.Errorf("got plan %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("Unknown target is rejected", func(t *testing.T) {
+		if _, err := migrations.Plan(all, nil, 7); err == nil {
+			t.Error("expected an error")
+		}
+	})
+
+	t.Run("Check refuses a schema that is behind", func(t *testing.T) {
+		err := migrations.Check(all, []migrations.Record{record(all[0])})
+		if !errors.Is(err, migrations.ErrSchemaBehind) {
+			t.Errorf("expected ErrSchemaBehind, got %v", err)
+		}
+		if err := migrations.Check(all, []migrations.Record{record(all[0]), record(all[1]), record(all[2])}); err != nil {
+			t.Errorf("expected an up to date schema to pass, got %v", err)
+		}
+	})
+
+	t.Run("Status reports every state", func(t *testing.T) {
+		statuses := migrations.Statuses(all, []migrations.Record{
+			record(all[0]),
+			{Version: 2, Name: "b", Checksum: "stale"},
+			{Version: 9, Name: "future"},
+		})
+		want := []migrations.State{migrations.StateApplied, migrations.StateModified, migrations.StatePending, migrations.StateUnknown}
+		if len(statuses) != len(want) {
+			t.Fatalf("expected %d statuses, got %+v", len(want), statuses)
+		}
+		for i, status := range statuses {
+			if status.State != want[i] {
+				t.Errorf("status %d: got %s, want %s", status.Version, status.State, want[i])
+			}
+		}
+	})
+}
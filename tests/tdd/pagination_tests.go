@@ -0,0 +1,121 @@
+package tdd
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/JonJenson-MFIn/project-management-system-api/cache"
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/directives"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/vektah/gqlparser/v2/ast"
+	"gorm.io/gorm"
+)
+
+// capturedQueries records the SQL of every query run against a dry-run database
+func capturedQueries(t *testing.T, database *gorm.DB) *[]string {
+	t.Helper()
+	var queries []string
+	err := database.Callback().Query().After("gorm:query").Register("test:capture", func(tx *gorm.DB) {
+		queries = append(queries, tx.Dialector.Explain(tx.Statement.SQL.String(), tx.Statement.Vars...))
+	})
+	if err != nil {
+		t.Fatalf("failed to register capture callback: %v", err)
+	}
+	return &queries
+}
+
+func TestCursorEncoding(t *testing.T) {
+	t.Run("Cursor round-trips", func(t *testing.T) {
+		cursor := db.Cursor{Keys: []string{"createdAt", "id"}, Values: []string{"2024-03-01T12:30:00.123456Z", "42"}}
+		decoded, err := db.DecodeCursor(cursor.Encode())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !slices.Equal(decoded.Keys, cursor.Keys) || !slices.Equal(decoded.Values, cursor.Values) {
+			t.Errorf("got %+v, want %+v", decoded, cursor)
+		}
+	})
+
+	t.Run("Foreign cursor is rejected", func(t *testing.T) {
+		if _, err := db.DecodeCursor("not-a-cursor"); !errors.Is(err, db.ErrInvalidCursor) {
+			t.Errorf("expected ErrInvalidCursor, got %v", err)
+		}
+	})
+}
+
+func TestPaginate(t *testing.T) {
+	after := db.Cursor{Keys: []string{"createdAt", "id"}, Values: []string{"2024-03-01T00:00:00Z", "9"}}.Encode()
+	sortedAfter := db.Cursor{Keys: []string{"priority", "createdAt", "id"}, Values: []string{"3", "2024-03-01T00:00:00Z", "9"}}.Encode()
+
+	tests := []struct {
+		name    string
+		args    db.PageArgs
+		want    []string
+		wantErr string
+	}{
+		{"Default page is ordered oldest first", db.PageArgs{}, []string{"ORDER BY tickets.created_at ASC, tickets.id ASC LIMIT 21"}, ""},
+		{"After cursor seeks past the key", db.PageArgs{First: intPtr(5), After: &after}, []string{"(tickets.created_at, tickets.id) > ('2024-03-01T00:00:00Z'", "LIMIT 6"}, ""},
+		{"Last pages backwards from the before cursor", db.PageArgs{Last: intPtr(5), Before: &after}, []string{"(tickets.created_at, tickets.id) < ('2024-03-01T00:00:00Z'", "ORDER BY tickets.created_at DESC, tickets.id DESC LIMIT 6"}, ""},
+		{"First and last cannot be combined", db.PageArgs{First: intPtr(5), Last: intPtr(5)}, nil, "cannot be combined"},
+		{"Page size is capped", db.PageArgs{First: intPtr(db.MaxPageSize + 1)}, nil, "first must be between"},
+		{"Invalid cursor is rejected", db.PageArgs{After: strPtr("bogus")}, nil, "invalid cursor"},
+		{"Cursor from another sort order is rejected", db.PageArgs{After: &after, Sort: []*model.SortInput{{Field: "priority", Direction: model.SortDirectionDesc}}}, nil, "invalid cursor"},
+		{"Mixed directions seek with an expanded condition", db.PageArgs{After: &sortedAfter, Sort: []*model.SortInput{{Field: "priority", Direction: model.SortDirectionDesc}, {Field: "createdAt", Direction: model.SortDirectionAsc}}}, []string{"END < '3') OR", "END = '3' AND tickets.created_at > '2024-03-01T00:00:00Z') OR", "tickets.created_at = '2024-03-01T00:00:00Z' AND tickets.id > '9'"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database := dryRunDB(t)
+			queries := capturedQueries(t, database)
+
+			_, err := db.Paginate(database.Model(&db.Ticket{}), db.TicketSorts, tt.args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(*queries) != 2 || !strings.Contains((*queries)[0], "count(*)") {
+				t.Fatalf("expected a count and a page query, got %v", *queries)
+			}
+			for _, fragment := range tt.want {
+				if !strings.Contains((*queries)[1], fragment) {
+					t.Errorf("expected %q in %s", fragment, (*queries)[1])
+				}
+			}
+		})
+	}
+}
+
+func TestCacheTagsConnections(t *testing.T) {
+	backend := cache.NewLRU(10)
+	responseCache := directives.NewResponseCache(backend)
+	ctx := graphql.WithFieldContext(context.Background(), &graphql.FieldContext{
+		Object: "Query",
+		Field: graphql.CollectedField{Field: &ast.Field{
+			Name:       "tickets",
+			Definition: &ast.FieldDefinition{Name: "tickets", Type: ast.NonNullNamedType("TicketConnection", nil)},
+		}},
+	})
+
+	next := func(ctx context.Context) (interface{}, error) {
+		return "page", nil
+	}
+	if _, err := responseCache.Directive(ctx, nil, next, 30); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	backend.InvalidateTags("tickets")
+	if backend.Len() != 0 {
+		t.Errorf("expected the connection entry to be tagged with tickets")
+	}
+}
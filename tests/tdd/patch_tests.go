@@ -0,0 +1,151 @@
+package tdd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+)
+
+func TestPatchInputs(t *testing.T) {
+	ctx := context.Background()
+	admin := &model.AuthUser{ID: 1, Role: model.RoleAdmin}
+
+	// The seeded "Fix login" ticket is 6, assigned to engineer 3 in project 2
+	t.Run("Omitted fields are left as they are", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		errs := responseErrors(t, presentingServer(repos, true), admin, GraphQLRequest{
+			Query: `mutation { updateTicket(id: 6, input: { priority: HIGH }) { id } }`,
+		})
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %+v", errs)
+		}
+
+		ticket, _ := repos.Tickets.Get(ctx, 6)
+		if ticket.Priority != "HIGH" || ticket.Title != "Fix login" || ticket.AssignedToID == nil || *ticket.AssignedToID != 3 {
+			t.Errorf("expected only the priority to change, got %+v", ticket)
+		}
+	})
+
+	t.Run("Explicit null clears an optional field", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		errs := responseErrors(t, presentingServer(repos, true), admin, GraphQLRequest{
+			Query: `mutation { updateTicket(id: 6, input: { assignedToID: null, description: null }) { id } }`,
+		})
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %+v", errs)
+		}
+
+		ticket, _ := repos.Tickets.Get(ctx, 6)
+		if ticket.AssignedToID != nil || ticket.Description != nil || ticket.Title != "Fix login" {
+			t.Errorf("expected the assignee and description to be cleared, got %+v", ticket)
+		}
+	})
+
+	t.Run("Null in variables clears like a literal", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		errs := responseErrors(t, presentingServer(repos, true), admin, GraphQLRequest{
+			Query:     `mutation($input: TicketPatchInput!) { updateTicket(id: 6, input: $input) { id } }`,
+			Variables: map[string]interface{}{"input": map[string]interface{}{"assignedToID": nil}},
+		})
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %+v", errs)
+		}
+
+		if ticket, _ := repos.Tickets.Get(ctx, 6); ticket.AssignedToID != nil {
+			t.Errorf("expected the assignee to be cleared, got %d", *ticket.AssignedToID)
+		}
+	})
+
+	t.Run("Null is rejected where the column cannot be empty", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		errs := responseErrors(t, presentingServer(repos, true), admin, GraphQLRequest{
+			Query: `mutation { updateTicket(id: 6, input: { title: null, status: null }) { id } }`,
+		})
+
+		messages := []string{"input.title must not be null", "input.status must not be null"}
+		if len(errs) != len(messages) {
+			t.Fatalf("expected %d errors, got %+v", len(messages), errs)
+		}
+		for i, err := range errs {
+			if err.Extensions["code"] != "VALIDATION_FAILED" || err.Message != messages[i] {
+				t.Errorf("expected VALIDATION_FAILED %q, got %v %q", messages[i], err.Extensions["code"], err.Message)
+			}
+		}
+		if ticket, _ := repos.Tickets.Get(ctx, 6); ticket.Title != "Fix login" {
+			t.Errorf("ticket changed despite the violation: %+v", ticket)
+		}
+	})
+
+	t.Run("Completing a ticket stamps completedAt", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		errs := responseErrors(t, presentingServer(repos, true), admin, GraphQLRequest{
+			Query: `mutation { updateTicket(id: 6, input: { status: COMPLETED }) { id } }`,
+		})
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %+v", errs)
+		}
+
+		if ticket, _ := repos.Tickets.Get(ctx, 6); ticket.Status != db.StatusCompletedDB || ticket.CompletedAt == nil {
+			t.Errorf("expected a completed ticket with completedAt, got %+v", ticket)
+		}
+	})
+
+	t.Run("Task due date can be moved and removed", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		due := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+		task := &db.Task{Title: "Write docs", DueDate: &due, Status: db.StatusNotStartedDB}
+		if err := repos.Tasks.Create(ctx, task); err != nil {
+			t.Fatal(err)
+		}
+		srv := presentingServer(repos, true)
+
+		errs := responseErrors(t, srv, admin, GraphQLRequest{
+			Query:     `mutation($id: Int!) { updateTask(id: $id, input: { dueDate: "2025-01-31" }) { id } }`,
+			Variables: map[string]interface{}{"id": task.ID},
+		})
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %+v", errs)
+		}
+		if got, _ := repos.Tasks.Get(ctx, task.ID); got.DueDate == nil || got.DueDate.Format("2006-01-02") != "2025-01-31" {
+			t.Errorf("expected the due date to move, got %v", got.DueDate)
+		}
+
+		errs = responseErrors(t, srv, admin, GraphQLRequest{
+			Query:     `mutation($id: Int!) { updateTask(id: $id, input: { dueDate: null }) { id } }`,
+			Variables: map[string]interface{}{"id": task.ID},
+		})
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %+v", errs)
+		}
+		if got, _ := repos.Tasks.Get(ctx, task.ID); got.DueDate != nil || got.Title != "Write docs" {
+			t.Errorf("expected the due date to be removed, got %+v", got)
+		}
+	})
+	t.Run("Task due dates read back as dates everywhere", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		due := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+		task := &db.Task{Title: "Write docs", DueDate: &due, Status: db.StatusNotStartedDB}
+		if err := repos.Tasks.Create(ctx, task); err != nil {
+			t.Fatal(err)
+		}
+
+		data := responseData(t, presentingServer(repos, true), admin, GraphQLRequest{
+			Query:     `query($id: Int!) { tasks { edges { node { dueDate } } } task(id: $id) { dueDate } }`,
+			Variables: map[string]interface{}{"id": task.ID},
+		})
+		node := data["tasks"].(map[string]interface{})["edges"].([]interface{})[0].(map[string]interface{})["node"].(map[string]interface{})
+		if node["dueDate"] != "2025-01-15" || data["task"].(map[string]interface{})["dueDate"] != "2025-01-15" {
+			t.Errorf("expected 2025-01-15 from both queries, got %v and %v", node["dueDate"], data["task"])
+		}
+	})
+}
@@ -0,0 +1,363 @@
+package tdd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/JonJenson-MFIn/project-management-system-api/policy"
+)
+
+// fakeDirectory answers policy lookups from fixed maps instead of the database
+type fakeDirectory struct {
+	projectManagers map[int]int
+	ledEngineers    map[int][]int
+	memberProjects  map[int][]int
+	roles           map[int]model.Role
+}
+
+func (d fakeDirectory) ProjectManagerID(ctx context.Context, projectID int) (*int, error) {
+	managerID, ok := d.projectManagers[projectID]
+	if !ok {
+		return nil, nil
+	}
+	return &managerID, nil
+}
+
+func (d fakeDirectory) LedEngineerIDs(ctx context.Context, leaderID int) ([]int, error) {
+	return d.ledEngineers[leaderID], nil
+}
+
+func (d fakeDirectory) MemberProjectIDs(ctx context.Context, employeeID int) ([]int, error) {
+	return d.memberProjects[employeeID], nil
+}
+
+func (d fakeDirectory) ManagedProjectIDs(ctx context.Context, managerID int) ([]int, error) {
+	var ids []int
+	for projectID, id := range d.projectManagers {
+		if id == managerID {
+			ids = append(ids, projectID)
+		}
+	}
+	return ids, nil
+}
+
+func (d fakeDirectory) EmployeeRole(ctx context.Context, employeeID int) (*model.Role, error) {
+	role, ok := d.roles[employeeID]
+	if !ok {
+		return nil, nil
+	}
+	return &role, nil
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+// Fixture: employee 1 is ADMIN, 2 is MANAGER of project 10, 3 is TL of team 20 with
+// engineers 4 and 5, and 4 and 6 are EMPLOYEEs. Employee 6 is a member of project 12.
+// Employee 99 is another MANAGER, of project 11.
+var (
+	policyAdmin    = &model.AuthUser{ID: 1, Role: model.RoleAdmin}
+	policyManager  = &model.AuthUser{ID: 2, Role: model.RoleManager}
+	policyLeader   = &model.AuthUser{ID: 3, Role: model.RoleTl}
+	policyEngineer = &model.AuthUser{ID: 4, Role: model.RoleEmployee}
+	policyOutsider = &model.AuthUser{ID: 6, Role: model.RoleEmployee}
+
+	policyEngine = &policy.Engine{
+		Directory: fakeDirectory{
+			projectManagers: map[int]int{10: 2, 11: 99},
+			ledEngineers:    map[int][]int{3: {4, 5}},
+			memberProjects:  map[int][]int{6: {12}},
+			roles: map[int]model.Role{
+				1: model.RoleAdmin, 2: model.RoleManager, 3: model.RoleTl, 4: model.RoleEmployee, 6: model.RoleEmployee,
+				99: model.RoleManager,
+			},
+		},
+	}
+)
+
+func TestProjectPolicy(t *testing.T) {
+	ownProject := &db.Project{ID: 10, ManagerID: intPtr(2)}
+	otherProject := &db.Project{ID: 11, ManagerID: intPtr(99)}
+
+	tests := []struct {
+		name    string
+		user    *model.AuthUser
+		project *db.Project
+		wantErr bool
+	}{
+		{"ADMIN can manage any project", policyAdmin, otherProject, false},
+		{"MANAGER can manage own project", policyManager, ownProject, false},
+		{"MANAGER cannot manage another manager's project", policyManager, otherProject, true},
+		{"TL cannot manage projects", policyLeader, ownProject, true},
+		{"EMPLOYEE cannot manage projects", policyEngineer, ownProject, true},
+		{"Anonymous caller cannot manage projects", nil, ownProject, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policyEngine.CanManageProject(context.Background(), tt.user, tt.project)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got err %v, wantErr=%v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, policy.ErrForbidden) {
+				t.Errorf("expected ErrForbidden, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCreateProjectPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		user      *model.AuthUser
+		managerID *int
+		wantErr   bool
+	}{
+		{"ADMIN can create a project for any manager", policyAdmin, intPtr(99), false},
+		{"MANAGER can create a project they manage", policyManager, intPtr(2), false},
+		{"MANAGER can create an unmanaged project", policyManager, nil, false},
+		{"MANAGER cannot create a project for another manager", policyManager, intPtr(99), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policyEngine.CanCreateProject(context.Background(), tt.user, tt.managerID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got err %v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTeamPolicy(t *testing.T) {
+	ledTeam := &db.Team{ID: 20, TeamLeaderID: intPtr(2)}
+	otherTeam := &db.Team{ID: 21, TeamLeaderID: intPtr(98)}
+
+	tests := []struct {
+		name    string
+		user    *model.AuthUser
+		team    *db.Team
+		wantErr bool
+	}{
+		{"ADMIN can change any team", policyAdmin, otherTeam, false},
+		{"MANAGER can change the team they lead", policyManager, ledTeam, false},
+		{"MANAGER cannot change another team", policyManager, otherTeam, true},
+		{"Anonymous caller cannot change teams", nil, ledTeam, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policyEngine.CanManageTeam(context.Background(), tt.user, tt.team)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got err %v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+
+	creates := []struct {
+		name     string
+		user     *model.AuthUser
+		leaderID *int
+		wantErr  bool
+	}{
+		{"ADMIN can create a team led by any manager", policyAdmin, intPtr(99), false},
+		{"MANAGER can create a team they lead", policyManager, intPtr(2), false},
+		{"MANAGER can create a team without a leader", policyManager, nil, false},
+		{"MANAGER can create a team led by a TL", policyManager, intPtr(3), false},
+		{"MANAGER can create a team led by an employee", policyManager, intPtr(4), false},
+		{"MANAGER cannot create a team led by another manager", policyManager, intPtr(99), true},
+		{"MANAGER cannot create a team led by an admin", policyManager, intPtr(1), true},
+		{"MANAGER cannot create a team led by an unknown employee", policyManager, intPtr(404), true},
+	}
+
+	for _, tt := range creates {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policyEngine.CanCreateTeam(context.Background(), tt.user, tt.leaderID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got err %v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOwnershipMutations(t *testing.T) {
+	// Employee 5 is not the manager of project 2 nor the leader of team 4
+	stranger := &model.AuthUser{ID: 5, Role: model.RoleManager}
+	owner := &model.AuthUser{ID: 1, Role: model.RoleManager}
+
+	for _, mutation := range []string{
+		`mutation { addProjectTeam(input: { projectID: 2, teamID: 4 }) { teamID } }`,
+		`mutation { removeProjectTeam(input: { projectID: 2, teamID: 4 }) }`,
+		`mutation { addProjectEmployee(input: { projectID: 2, employeeID: 5, role: "MEMBER" }) { employeeID } }`,
+		`mutation { removeProjectEmployee(input: { projectID: 2, employeeID: 3, role: "MEMBER" }) }`,
+		`mutation { updateProjectEmployeeRole(input: { projectID: 2, employeeID: 3, role: "LEAD" }) { role } }`,
+		`mutation { updateTeam(id: 4, input: { name: "Taken" }) { name } }`,
+		`mutation { deleteTeam(id: 4) }`,
+	} {
+		t.Run(mutation, func(t *testing.T) {
+			got := firstError(t, presentingServer(seedMemory(t), true), stranger, mutation)
+			if got.Extensions["code"] != "FORBIDDEN" {
+				t.Errorf("expected FORBIDDEN, got %v %q", got.Extensions["code"], got.Message)
+			}
+		})
+	}
+
+	t.Run("The owner makes the same changes", func(t *testing.T) {
+		srv := presentingServer(seedMemory(t), true)
+		for _, mutation := range []string{
+			`mutation { addProjectEmployee(input: { projectID: 2, employeeID: 5, role: "MEMBER" }) { employeeID } }`,
+			`mutation { updateTeam(id: 4, input: { name: "Core" }) { name } }`,
+		} {
+			if errs := responseErrors(t, srv, owner, GraphQLRequest{Query: mutation}); len(errs) != 0 {
+				t.Errorf("unexpected errors for %s: %+v", mutation, errs)
+			}
+		}
+	})
+}
+
+func TestTeamMembersPolicy(t *testing.T) {
+	ledTeam := &db.Team{ID: 20, TeamLeaderID: intPtr(3)}
+	otherTeam := &db.Team{ID: 21, TeamLeaderID: intPtr(98)}
+
+	tests := []struct {
+		name    string
+		user    *model.AuthUser
+		team    *db.Team
+		wantErr bool
+	}{
+		{"ADMIN can manage any team", policyAdmin, otherTeam, false},
+		{"MANAGER can manage any team", policyManager, otherTeam, false},
+		{"TL can manage the team they lead", policyLeader, ledTeam, false},
+		{"TL cannot manage another team", policyLeader, otherTeam, true},
+		{"EMPLOYEE cannot manage teams", policyEngineer, ledTeam, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policyEngine.CanManageTeamMembers(context.Background(), tt.user, tt.team)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got err %v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTicketPolicy(t *testing.T) {
+	assignedToEngineer := &db.Ticket{ID: 30, ProjectID: 11, AssignedToID: intPtr(4)}
+	inManagedProject := &db.Ticket{ID: 31, ProjectID: 10, AssignedToID: intPtr(6)}
+	unassigned := &db.Ticket{ID: 32, ProjectID: 11}
+
+	tests := []struct {
+		name    string
+		user    *model.AuthUser
+		ticket  *db.Ticket
+		wantErr bool
+	}{
+		{"ADMIN can move any ticket", policyAdmin, unassigned, false},
+		{"MANAGER can move tickets in a managed project", policyManager, inManagedProject, false},
+		{"MANAGER cannot move tickets in other projects", policyManager, assignedToEngineer, true},
+		{"TL can move tickets of their engineers", policyLeader, assignedToEngineer, false},
+		{"TL cannot move tickets outside their team", policyLeader, inManagedProject, true},
+		{"EMPLOYEE can move own ticket", policyEngineer, assignedToEngineer, false},
+		{"EMPLOYEE cannot move someone else's ticket", policyOutsider, assignedToEngineer, true},
+		{"EMPLOYEE cannot move unassigned ticket", policyEngineer, unassigned, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policyEngine.CanModifyTicket(context.Background(), tt.user, tt.ticket)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got err %v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTaskPolicy(t *testing.T) {
+	assignedToEngineer := &db.Task{ID: 40, AssignedToID: intPtr(4)}
+	inManagedProject := &db.Task{ID: 41, ProjectID: intPtr(10)}
+	withoutProject := &db.Task{ID: 42}
+
+	tests := []struct {
+		name    string
+		user    *model.AuthUser
+		task    *db.Task
+		wantErr bool
+	}{
+		{"ADMIN can move any task", policyAdmin, withoutProject, false},
+		{"MANAGER can move tasks in a managed project", policyManager, inManagedProject, false},
+		{"MANAGER cannot move tasks without a project", policyManager, withoutProject, true},
+		{"TL can move tasks of their engineers", policyLeader, assignedToEngineer, false},
+		{"TL cannot move unassigned tasks", policyLeader, inManagedProject, true},
+		{"EMPLOYEE can move own task", policyEngineer, assignedToEngineer, false},
+		{"EMPLOYEE cannot move someone else's task", policyOutsider, assignedToEngineer, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policyEngine.CanModifyTask(context.Background(), tt.user, tt.task)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got err %v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNotificationPolicy(t *testing.T) {
+	tests := []struct {
+		name       string
+		user       *model.AuthUser
+		employeeID int
+		wantErr    bool
+	}{
+		{"ADMIN can read anyone's notifications", policyAdmin, 4, false},
+		{"EMPLOYEE can read own notifications", policyEngineer, 4, false},
+		{"MANAGER cannot read someone else's notifications", policyManager, 4, true},
+		{"EMPLOYEE cannot read someone else's notifications", policyOutsider, 4, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policyEngine.CanAccessNotifications(context.Background(), tt.user, tt.employeeID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got err %v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestViewWorkPolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		user         *model.AuthUser
+		assignedToID *int
+		projectID    *int
+		want         bool
+	}{
+		{"ADMIN can see any work", policyAdmin, nil, intPtr(11), true},
+		{"EMPLOYEE can see work assigned to them", policyEngineer, intPtr(4), intPtr(11), true},
+		{"EMPLOYEE can see work in a project they belong to", policyOutsider, nil, intPtr(12), true},
+		{"EMPLOYEE cannot see other work", policyOutsider, intPtr(4), intPtr(11), false},
+		{"MANAGER can see work in a managed project", policyManager, nil, intPtr(10), true},
+		{"MANAGER cannot see work in other projects", policyManager, intPtr(4), intPtr(11), false},
+		{"TL can see work of their engineers", policyLeader, intPtr(5), intPtr(11), true},
+		{"TL cannot see work outside their team", policyLeader, intPtr(6), nil, false},
+		{"Anonymous caller cannot see work", nil, intPtr(4), intPtr(11), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := policyEngine.CanViewWork(context.Background(), tt.user, tt.assignedToID, tt.projectID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
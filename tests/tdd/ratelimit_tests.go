@@ -0,0 +1,85 @@
+package tdd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/JonJenson-MFIn/project-management-system-api/directives"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func signInFieldContext(ctx context.Context) context.Context {
+	return graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+		Field:  graphql.CollectedField{Field: &ast.Field{Name: "signIn"}},
+	})
+}
+
+// clientContext returns the context ClientIP attaches to a request from remoteAddr
+func clientContext(remoteAddr string) context.Context {
+	var ctx context.Context
+	handler := middleware.ClientIP(false)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	}))
+
+	req := httptest.NewRequest("POST", "/", nil)
+	req.RemoteAddr = remoteAddr
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	return ctx
+}
+
+func TestRateLimitDirective(t *testing.T) {
+	tests := []struct {
+		name       string
+		user       *model.AuthUser
+		calls      int
+		limit      int
+		window     string
+		wantErrors int
+	}{
+		{"Calls within the limit pass", nil, 3, 3, "1m", 0},
+		{"Calls over the limit are rejected", nil, 5, 3, "1m", 2},
+		{"Authenticated caller within the limit passes", &model.AuthUser{ID: 7, Role: model.RoleEmployee}, 3, 3, "1m", 0},
+		{"Invalid window is rejected", nil, 1, 3, "soon", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := directives.NewRateLimiter()
+
+			next := func(ctx context.Context) (interface{}, error) {
+				return true, nil
+			}
+
+			errorCount := 0
+			for i := 0; i < tt.calls; i++ {
+				ctx := signInFieldContext(clientContext("203.0.113.7:5000"))
+				if tt.user != nil {
+					ctx = middleware.WithUser(ctx, tt.user)
+				}
+
+				_, err := limiter.Directive(ctx, nil, next, tt.limit, tt.window)
+				if err == nil {
+					continue
+				}
+				errorCount++
+
+				var gqlErr *gqlerror.Error
+				if tt.window == "1m" && (!errors.As(err, &gqlErr) || gqlErr.Extensions["code"] != "RATE_LIMITED" || gqlErr.Extensions["retryAfter"] == nil) {
+					t.Errorf("expected RATE_LIMITED error with retryAfter, got %v", err)
+				}
+			}
+
+			if errorCount != tt.wantErrors {
+				t.Errorf("got %d errors, want %d", errorCount, tt.wantErrors)
+			}
+		})
+	}
+}
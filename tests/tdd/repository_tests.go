@@ -0,0 +1,228 @@
+package tdd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/JonJenson-MFIn/project-management-system-api/cache"
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/directives"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/generated"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/resolvers"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"github.com/JonJenson-MFIn/project-management-system-api/repository"
+)
+
+// seedMemory fills an in-memory store with a manager (1) of project 2, an engineer (3)
+// on team 4 of that project, an outsider (5), and one ticket per employee
+func seedMemory(t *testing.T) *repository.Repositories {
+	t.Helper()
+	ctx := context.Background()
+	repos := repository.NewMemory().Repositories()
+
+	must := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("seeding failed: %v", err)
+		}
+	}
+
+	manager := &db.Employee{Name: "Maria Manager", Email: "maria@example.com", Role: db.RoleManagerDB, Active: true}
+	must(repos.Employees.Create(ctx, manager))
+	project := &db.Project{Name: "Apollo", ManagerID: &manager.ID, Status: db.StatusInProgressDB}
+	must(repos.Projects.Create(ctx, project))
+	engineer := &db.Employee{Name: "Eddie Engineer", Email: "eddie@example.com", Role: db.RoleEmployeeDB, Active: true}
+	must(repos.Employees.Create(ctx, engineer))
+	team := &db.Team{Name: "Platform", TeamLeaderID: &manager.ID}
+	must(repos.Teams.Create(ctx, team))
+	outsider := &db.Employee{Name: "Olga Outsider", Email: "olga@example.com", Role: db.RoleEmployeeDB, Active: true}
+	must(repos.Employees.Create(ctx, outsider))
+
+	must(repos.Projects.AddTeam(ctx, &db.ProjectTeam{ProjectID: project.ID, TeamID: team.ID}))
+	must(repos.Teams.AddEngineer(ctx, &db.TeamEngineer{TeamID: team.ID, EngineerID: engineer.ID}))
+	must(repos.Tickets.Create(ctx, &db.Ticket{ProjectID: project.ID, AssignedToID: &engineer.ID, Title: "Fix login", Status: db.StatusNotStartedDB}))
+	must(repos.Tickets.Create(ctx, &db.Ticket{ProjectID: 99, AssignedToID: &outsider.ID, Title: "Elsewhere", Status: db.StatusNotStartedDB}))
+	return repos
+}
+
+func TestMemoryRepositories(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Create assigns ids and timestamps", func(t *testing.T) {
+		repos := seedMemory(t)
+		employee := &db.Employee{Name: "New Hire", Email: "new@example.com", Role: db.RoleEmployeeDB}
+		if err := repos.Employees.Create(ctx, employee); err != nil {
+			t.Fatal(err)
+		}
+		if employee.ID == 0 || employee.CreatedAt.IsZero() {
+			t.Errorf("expected id and createdAt to be set, got %+v", employee)
+		}
+		if err := repos.Employees.Create(ctx, &db.Employee{Email: "new@example.com"}); err == nil {
+			t.Error("expected a duplicate email to be rejected")
+		}
+	})
+
+	t.Run("Missing rows return ErrNotFound", func(t *testing.T) {
+		repos := seedMemory(t)
+		if _, err := repos.Projects.Get(ctx, 404); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+		if _, err := repos.Employees.GetByEmail(ctx, "nobody@example.com"); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("List applies filters", func(t *testing.T) {
+		repos := seedMemory(t)
+		role := model.RoleEmployee
+		page, err := repos.Employees.List(ctx, &model.EmployeeFilter{Name: strPtr("EDD"), Role: &role}, db.PageArgs{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if page.TotalCount != 1 || page.Rows[0].Name != "Eddie Engineer" {
+			t.Errorf("expected only Eddie Engineer, got %+v", page.Rows)
+		}
+	})
+
+	t.Run("List pages through a sorted order", func(t *testing.T) {
+		repos := seedMemory(t)
+		sort := []*model.SortInput{{Field: "name", Direction: model.SortDirectionDesc}}
+		first := 2
+		page, err := repos.Employees.List(ctx, nil, db.PageArgs{First: &first, Sort: sort})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if names := employeeNames(page.Rows); names != "Olga Outsider,Maria Manager" || !page.PageInfo.HasNextPage {
+			t.Fatalf("unexpected first page %q, hasNextPage=%v", names, page.PageInfo.HasNextPage)
+		}
+
+		page, err = repos.Employees.List(ctx, nil, db.PageArgs{First: &first, After: page.PageInfo.EndCursor, Sort: sort})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if names := employeeNames(page.Rows); names != "Eddie Engineer" || page.PageInfo.HasNextPage {
+			t.Errorf("unexpected second page %q, hasNextPage=%v", names, page.PageInfo.HasNextPage)
+		}
+	})
+
+	t.Run("Tickets are scoped to the viewer", func(t *testing.T) {
+		repos := seedMemory(t)
+		viewers := map[string]struct {
+			user *model.AuthUser
+			want int
+		}{
+			"manager":  {&model.AuthUser{ID: 1, Role: model.RoleManager}, 1},
+			"engineer": {&model.AuthUser{ID: 3, Role: model.RoleEmployee}, 1},
+			"outsider": {&model.AuthUser{ID: 5, Role: model.RoleEmployee}, 1},
+			"admin":    {&model.AuthUser{ID: 9, Role: model.RoleAdmin}, 2},
+			"nobody":   {nil, 0},
+		}
+		for name, viewer := range viewers {
+			page, err := repos.Tickets.List(ctx, viewer.user, nil, db.PageArgs{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if page.TotalCount != viewer.want {
+				t.Errorf("%s: expected %d tickets, got %d", name, viewer.want, page.TotalCount)
+			}
+		}
+	})
+
+	t.Run("Invalid due dates are rejected", func(t *testing.T) {
+		repos := seedMemory(t)
+		_, err := repos.Tasks.List(ctx, &model.AuthUser{ID: 1, Role: model.RoleAdmin}, &model.TaskFilter{DueDateAfter: strPtr("tomorrow")}, db.PageArgs{})
+		if err == nil {
+			t.Error("expected an invalid date error")
+		}
+	})
+
+	t.Run("Relationships are grouped by owner", func(t *testing.T) {
+		repos := seedMemory(t)
+		teams, err := repos.Teams.ListByProjects(ctx, []int{2, 404})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(teams[2]) != 1 || teams[2][0].Name != "Platform" || len(teams[404]) != 0 {
+			t.Errorf("unexpected teams by project: %+v", teams)
+		}
+	})
+}
+
+func TestResolversWithMemoryRepositories(t *testing.T) {
+	repos := seedMemory(t)
+	schema := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+		Resolvers: &resolvers.Resolver{Repos: repos},
+		Directives: generated.DirectiveRoot{
+			Auth:  directives.AuthDirective,
+			Cache: directives.NewResponseCache(cache.NewLRU(10)).Directive,
+		},
+	}))
+
+	run := func(t *testing.T, user *model.AuthUser, query string) map[string]interface{} {
+		t.Helper()
+		body, _ := json.Marshal(GraphQLRequest{Query: query})
+		req := httptest.NewRequest("POST", "/", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req = req.WithContext(middleware.WithUser(req.Context(), user))
+
+		w := httptest.NewRecorder()
+		schema.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("got status %d", w.Code)
+		}
+
+		var response struct {
+			Data   map[string]interface{} `json:"data"`
+			Errors []interface{}          `json:"errors"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatal(err)
+		}
+		if len(response.Errors) > 0 {
+			t.Fatalf("unexpected errors: %v", response.Errors)
+		}
+		return response.Data
+	}
+
+	t.Run("Employee is read from the repository", func(t *testing.T) {
+		data := run(t, nil, `query { employee(id: 1) { name role } }`)
+		employee := data["employee"].(map[string]interface{})
+		if employee["name"] != "Maria Manager" || employee["role"] != "MANAGER" {
+			t.Errorf("unexpected employee %v", employee)
+		}
+	})
+
+	t.Run("Tickets are scoped to the caller", func(t *testing.T) {
+		data := run(t, &model.AuthUser{ID: 3, Role: model.RoleEmployee}, `query { tickets { totalCount edges { node { title } } } }`)
+		tickets := data["tickets"].(map[string]interface{})
+		if tickets["totalCount"] != float64(1) {
+			t.Errorf("expected 1 visible ticket, got %v", tickets)
+		}
+	})
+
+	t.Run("Nested fields resolve without a database", func(t *testing.T) {
+		data := run(t, &model.AuthUser{ID: 1, Role: model.RoleManager}, `query { project(id: 2) { manager { name } teams { name engineers { name } } } }`)
+		raw, _ := json.Marshal(data)
+		for _, want := range []string{"Maria Manager", "Platform", "Eddie Engineer"} {
+			if !strings.Contains(string(raw), want) {
+				t.Errorf("expected %q in %s", want, raw)
+			}
+		}
+	})
+}
+
+func employeeNames(employees []db.Employee) string {
+	names := make([]string, len(employees))
+	for i, employee := range employees {
+		names[i] = employee.Name
+	}
+	return strings.Join(names, ",")
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/JonJenson-MFIn/project-management-system-api/graph/generated"
 	"github.com/JonJenson-MFIn/project-management-system-api/graph/resolvers"
+	"github.com/JonJenson-MFIn/project-management-system-api/repository"
 )
 
 func TestQueryResolvers(t *testing.T) {
@@ -19,12 +20,12 @@ func TestQueryResolvers(t *testing.T) {
 	}{
 		{
 			name:    "Query employees should work",
-			query:   `query { employees { id name } }`,
+			query:   `query { employees { edges { node { id name } } totalCount } }`,
 			wantErr: false,
 		},
 		{
 			name:    "Query projects should work",
-			query:   `query { projects { id name } }`,
+			query:   `query { projects(first: 10) { edges { cursor node { id name } } pageInfo { hasNextPage endCursor } } }`,
 			wantErr: false,
 		},
 		{
@@ -40,7 +41,7 @@ func TestQueryResolvers(t *testing.T) {
 				handler.NewDefaultServer(
 					generated.NewExecutableSchema(
 						generated.Config{
-							Resolvers: &resolvers.Resolver{},
+							Resolvers: &resolvers.Resolver{Repos: repository.NewMemory().Repositories()},
 						},
 					),
 				),
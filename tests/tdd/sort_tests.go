@@ -0,0 +1,76 @@
+package tdd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+)
+
+func TestSortInput(t *testing.T) {
+	asc, desc := model.SortDirectionAsc, model.SortDirectionDesc
+
+	tests := []struct {
+		name    string
+		sort    []*model.SortInput
+		want    string
+		wantErr string
+	}{
+		{"Priority sorts by urgency, not alphabetically", []*model.SortInput{{Field: "priority", Direction: desc}}, "ORDER BY CASE tasks.priority WHEN 'URGENT' THEN 4 WHEN 'HIGH' THEN 3 WHEN 'MEDIUM' THEN 2 ELSE 1 END DESC, tasks.created_at DESC, tasks.id DESC", ""},
+		{"Due date puts missing dates last", []*model.SortInput{{Field: "dueDate", Direction: asc}}, "ORDER BY COALESCE(tasks.due_date, 'infinity') ASC, tasks.created_at ASC, tasks.id ASC", ""},
+		{"Several fields apply in order", []*model.SortInput{{Field: "status", Direction: asc}, {Field: "title", Direction: desc}}, "ORDER BY tasks.status ASC, tasks.title DESC, tasks.created_at DESC, tasks.id DESC", ""},
+		{"Explicit createdAt is not repeated", []*model.SortInput{{Field: "createdAt", Direction: desc}}, "ORDER BY tasks.created_at DESC, tasks.id DESC", ""},
+		{"Unknown fields are rejected", []*model.SortInput{{Field: "password", Direction: asc}}, "", `cannot sort by "password"`},
+		{"SQL in the field name never reaches ORDER BY", []*model.SortInput{{Field: "id; DROP TABLE tasks", Direction: asc}}, "", "cannot sort by"},
+		{"Repeated fields are rejected", []*model.SortInput{{Field: "title", Direction: asc}, {Field: "title", Direction: desc}}, "", "more than once"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database := dryRunDB(t)
+			queries := capturedQueries(t, database)
+
+			_, err := db.Paginate(database.Model(&db.Task{}), db.TaskSorts, db.PageArgs{Sort: tt.sort})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				if len(*queries) != 0 {
+					t.Errorf("expected no queries, got %v", *queries)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.Contains((*queries)[1], tt.want) {
+				t.Errorf("expected %q in %s", tt.want, (*queries)[1])
+			}
+		})
+	}
+
+	t.Run("Every entity can be sorted by its tie-breakers", func(t *testing.T) {
+		for name, fields := range map[string][]string{
+			"employees":     keys(db.EmployeeSorts),
+			"projects":      keys(db.ProjectSorts),
+			"tasks":         keys(db.TaskSorts),
+			"tickets":       keys(db.TicketSorts),
+			"teams":         keys(db.TeamSorts),
+			"notifications": keys(db.NotificationSorts),
+		} {
+			joined := "," + strings.Join(fields, ",") + ","
+			if !strings.Contains(joined, ",createdAt,") || !strings.Contains(joined, ",id,") {
+				t.Errorf("%s sorts lack createdAt or id: %v", name, fields)
+			}
+		}
+	})
+}
+
+func keys[T any](sorts db.Sorts[T]) []string {
+	fields := make([]string, 0, len(sorts))
+	for field := range sorts {
+		fields = append(fields, field)
+	}
+	return fields
+}
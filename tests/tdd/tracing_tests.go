@@ -0,0 +1,116 @@
+package tdd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/JonJenson-MFIn/project-management-system-api/config"
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/generated"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/resolvers"
+	"github.com/JonJenson-MFIn/project-management-system-api/middleware"
+	"github.com/JonJenson-MFIn/project-management-system-api/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// recordSpans installs an in-memory exporter as the global provider for the test
+func recordSpans(t *testing.T) func() tracetest.SpanStubs {
+	t.Helper()
+	if _, err := tracing.Setup(context.Background(), config.Tracing{Exporter: config.TracingNone}); err != nil {
+		t.Fatal(err)
+	}
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := tracing.NewProvider(exporter, "test", 1)
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	return func() tracetest.SpanStubs {
+		if err := provider.ForceFlush(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		return exporter.GetSpans()
+	}
+}
+
+func findSpan(t *testing.T, spans tracetest.SpanStubs, name string) tracetest.SpanStub {
+	t.Helper()
+	for _, span := range spans {
+		if span.Name == name {
+			return span
+		}
+	}
+	names := make([]string, len(spans))
+	for i, span := range spans {
+		names[i] = span.Name
+	}
+	t.Fatalf("no span %q among %v", name, names)
+	return tracetest.SpanStub{}
+}
+
+func TestTracing(t *testing.T) {
+	t.Run("Request, operation and resolver spans continue the caller's trace", func(t *testing.T) {
+		spans := recordSpans(t)
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+			Resolvers: &resolvers.Resolver{Repos: seedMemory(t)},
+		}))
+		srv.Use(tracing.Extension{})
+
+		body, _ := json.Marshal(GraphQLRequest{Query: `query Roster { employees { edges { node { name } } } }`})
+		req := httptest.NewRequest("POST", "/query", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		middleware.AuthMiddleware(nil)(srv).ServeHTTP(httptest.NewRecorder(), req)
+
+		recorded := spans()
+		request := findSpan(t, recorded, "POST /query")
+		operation := findSpan(t, recorded, "query Roster")
+		field := findSpan(t, recorded, "Query.employees")
+
+		if request.SpanContext.TraceID().String() != "4bf92f3577b34da6a3ce929d0e0e4736" || request.Parent.SpanID().String() != "00f067aa0ba902b7" {
+			t.Errorf("request span did not continue the incoming trace: %v parent %v", request.SpanContext.TraceID(), request.Parent.SpanID())
+		}
+		if operation.Parent.SpanID() != request.SpanContext.SpanID() || field.Parent.SpanID() != operation.SpanContext.SpanID() {
+			t.Error("expected request > operation > resolver nesting")
+		}
+		for _, span := range recorded {
+			if span.Name == "Employee.name" {
+				t.Error("plain struct fields should not be traced")
+			}
+		}
+	})
+
+	t.Run("SQL statements are traced without bound values", func(t *testing.T) {
+		spans := recordSpans(t)
+		database := dryRunDB(t)
+		if err := database.Use(tracing.GormPlugin{}); err != nil {
+			t.Fatal(err)
+		}
+
+		ctx, parent := tracing.Tracer().Start(context.Background(), "parent")
+		var employees []db.Employee
+		database.WithContext(ctx).Where("email = ?", "secret@example.com").Find(&employees)
+		parent.End()
+
+		query := findSpan(t, spans(), "gorm.query")
+		if query.Parent.SpanID() != parent.SpanContext().SpanID() {
+			t.Error("expected the SQL span to be a child of the caller's span")
+		}
+		var text string
+		for _, attr := range query.Attributes {
+			if attr.Key == "db.query.text" {
+				text = attr.Value.AsString()
+			}
+		}
+		if !strings.Contains(text, `FROM "employees"`) || strings.Contains(text, "secret@example.com") {
+			t.Errorf("unexpected db.query.text %q", text)
+		}
+	})
+}
@@ -0,0 +1,255 @@
+package tdd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/JonJenson-MFIn/project-management-system-api/cache"
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/directives"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/generated"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/resolvers"
+	"github.com/JonJenson-MFIn/project-management-system-api/repository"
+)
+
+// retainingServer is presentingServer with a trash retention period
+func retainingServer(repos *repository.Repositories, retention time.Duration) http.Handler {
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+		Resolvers: &resolvers.Resolver{Repos: repos, TrashRetention: retention},
+		Directives: generated.DirectiveRoot{
+			Auth:      directives.AuthDirective,
+			Cache:     directives.NewResponseCache(cache.NewLRU(10)).Directive,
+			RateLimit: directives.NewRateLimiter().Directive,
+		},
+	}))
+	srv.SetErrorPresenter(resolvers.ErrorPresenter(true))
+	srv.Use(&directives.Constraints{})
+	return srv
+}
+
+func TestTrash(t *testing.T) {
+	ctx := context.Background()
+	admin := &model.AuthUser{ID: 1, Role: model.RoleAdmin}
+	engineer := &model.AuthUser{ID: 3, Role: model.RoleEmployee}
+
+	mutate := func(t *testing.T, srv http.Handler, query string) {
+		t.Helper()
+		if errs := responseErrors(t, srv, admin, GraphQLRequest{Query: query}); len(errs) != 0 {
+			t.Fatalf("unexpected errors for %s: %+v", query, errs)
+		}
+	}
+
+	t.Run("Deleting a project trashes its work and memberships", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		srv := presentingServer(repos, true)
+		mutate(t, srv, `mutation { deleteProject(id: 2) }`)
+
+		if _, err := repos.Tickets.Get(ctx, 6); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("expected the project's ticket to leave with it, got %v", err)
+		}
+		if teams, _ := repos.Projects.ListTeams(ctx, 2); len(teams) != 0 {
+			t.Errorf("expected the project's teams to leave with it, got %v", teams)
+		}
+
+		data := responseData(t, srv, admin, GraphQLRequest{Query: `{
+			trashedProjects { edges { node { name deletedAt } } }
+			trashedTickets { edges { node { title deletedAt } } }
+		}`})
+		raw, _ := json.Marshal(data)
+		if !strings.Contains(string(raw), `"name":"Apollo"`) || !strings.Contains(string(raw), `"title":"Fix login"`) || strings.Contains(string(raw), `"deletedAt":null`) {
+			t.Errorf("expected the project and its ticket in the trash with deletedAt, got %s", raw)
+		}
+	})
+
+	t.Run("Restore brings back only what was deleted together", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		srv := presentingServer(repos, true)
+		earlier := &db.Ticket{ProjectID: 2, Title: "Deleted before", Status: db.StatusNotStartedDB}
+		if err := repos.Tickets.Create(ctx, earlier); err != nil {
+			t.Fatal(err)
+		}
+		mutate(t, srv, fmt.Sprintf(`mutation { deleteTicket(id: %d) }`, earlier.ID))
+		mutate(t, srv, `mutation { deleteProject(id: 2) }`)
+
+		data := responseData(t, srv, admin, GraphQLRequest{Query: `mutation { restoreProject(id: 2) { name deletedAt } }`})
+		if project := data["restoreProject"].(map[string]interface{}); project["deletedAt"] != nil {
+			t.Errorf("expected a live project, got %v", project)
+		}
+		if _, err := repos.Tickets.Get(ctx, 6); err != nil {
+			t.Errorf("expected the project's ticket back, got %v", err)
+		}
+		if teams, _ := repos.Projects.ListTeams(ctx, 2); len(teams) != 1 {
+			t.Errorf("expected the project's team back, got %v", teams)
+		}
+		if _, err := repos.Tickets.GetTrashed(ctx, earlier.ID); err != nil {
+			t.Errorf("expected the ticket deleted on its own to stay in the trash, got %v", err)
+		}
+	})
+
+	t.Run("Comments leave and come back with their ticket", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		srv := presentingServer(repos, true)
+		comment := &db.Comment{TicketID: intPtr(6), AuthorID: intPtr(1), Body: "Looking into it"}
+		earlier := &db.Comment{TicketID: intPtr(6), AuthorID: intPtr(1), Body: "Deleted before"}
+		for _, c := range []*db.Comment{comment, earlier} {
+			if err := repos.Comments.Create(ctx, c); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := repos.Comments.Delete(ctx, earlier); err != nil {
+			t.Fatal(err)
+		}
+
+		mutate(t, srv, `mutation { deleteTicket(id: 6) }`)
+		if _, err := repos.Comments.Get(ctx, comment.ID); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("expected the ticket's comment to leave with it, got %v", err)
+		}
+
+		mutate(t, srv, `mutation { restoreTicket(id: 6) { id } }`)
+		if _, err := repos.Comments.Get(ctx, comment.ID); err != nil {
+			t.Errorf("expected the ticket's comment back, got %v", err)
+		}
+		if _, err := repos.Comments.Get(ctx, earlier.ID); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("expected the comment deleted on its own to stay in the trash, got %v", err)
+		}
+	})
+
+	t.Run("Comments on a project's work leave and come back with it", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		srv := presentingServer(repos, true)
+		task := &db.Task{ProjectID: intPtr(2), Title: "Write docs", Status: db.StatusNotStartedDB}
+		if err := repos.Tasks.Create(ctx, task); err != nil {
+			t.Fatal(err)
+		}
+		comments := []*db.Comment{
+			{TicketID: intPtr(6), AuthorID: intPtr(1), Body: "On the ticket"},
+			{TaskID: &task.ID, AuthorID: intPtr(1), Body: "On the task"},
+		}
+		for _, c := range comments {
+			if err := repos.Comments.Create(ctx, c); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		mutate(t, srv, `mutation { deleteProject(id: 2) }`)
+		for _, c := range comments {
+			if _, err := repos.Comments.Get(ctx, c.ID); !errors.Is(err, repository.ErrNotFound) {
+				t.Errorf("expected %q to leave with the project, got %v", c.Body, err)
+			}
+		}
+
+		mutate(t, srv, `mutation { restoreProject(id: 2) { id } }`)
+		for _, c := range comments {
+			if _, err := repos.Comments.Get(ctx, c.ID); err != nil {
+				t.Errorf("expected %q back with the project, got %v", c.Body, err)
+			}
+		}
+	})
+
+	t.Run("Restoring an employee brings back their memberships", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		srv := presentingServer(repos, true)
+		mutate(t, srv, `mutation { deleteEmployee(id: 3) }`)
+		if engineers, _ := repos.Teams.ListEngineers(ctx, 4); len(engineers) != 0 {
+			t.Fatalf("expected the engineer to leave the team, got %v", engineers)
+		}
+
+		mutate(t, srv, `mutation { restoreEmployee(id: 3) { id } }`)
+		if engineers, _ := repos.Teams.ListEngineers(ctx, 4); len(engineers) != 1 {
+			t.Errorf("expected the engineer back in the team, got %v", engineers)
+		}
+	})
+
+	t.Run("Work is not restored into a trashed project", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		srv := presentingServer(repos, true)
+		mutate(t, srv, `mutation { deleteProject(id: 2) }`)
+
+		got := firstError(t, srv, admin, `mutation { restoreTicket(id: 6) { id } }`)
+		if got.Extensions["code"] != "VALIDATION_FAILED" || got.Message != "project 2 is in the trash; restore it first" {
+			t.Errorf("expected VALIDATION_FAILED, got %v %q", got.Extensions["code"], got.Message)
+		}
+	})
+
+	t.Run("Only the team's leader restores a team", func(t *testing.T) {
+		repos := seedMemory(t)
+		srv := presentingServer(repos, true)
+		mutate(t, srv, `mutation { deleteTeam(id: 4) }`)
+
+		// Employee 5 is not the leader of team 4
+		stranger := &model.AuthUser{ID: 5, Role: model.RoleManager}
+		got := firstError(t, srv, stranger, `mutation { restoreTeam(id: 4) { id } }`)
+		if got.Extensions["code"] != "FORBIDDEN" {
+			t.Errorf("expected FORBIDDEN, got %v %q", got.Extensions["code"], got.Message)
+		}
+		if _, err := repos.Teams.GetTrashed(ctx, 4); err != nil {
+			t.Errorf("expected team 4 to stay in the trash, got %v", err)
+		}
+	})
+
+	t.Run("Removed memberships can be added again", func(t *testing.T) {
+		repos := seedMemory(t)
+		if err := repos.Projects.RemoveTeam(ctx, 2, 4); err != nil {
+			t.Fatal(err)
+		}
+		if err := repos.Projects.AddTeam(ctx, &db.ProjectTeam{ProjectID: 2, TeamID: 4}); err != nil {
+			t.Errorf("expected the removed team to be added back, got %v", err)
+		}
+		if err := repos.Projects.AddTeam(ctx, &db.ProjectTeam{ProjectID: 2, TeamID: 4}); !errors.Is(err, db.ErrConflict) {
+			t.Errorf("expected a live duplicate to conflict, got %v", err)
+		}
+	})
+
+	t.Run("Only admins purge", func(t *testing.T) {
+		got := firstError(t, presentingServer(seedMemory(t), true), engineer, `mutation { purgeTrash { projects } }`)
+		if got.Extensions["code"] != "FORBIDDEN" {
+			t.Errorf("expected FORBIDDEN, got %v %q", got.Extensions["code"], got.Message)
+		}
+	})
+
+	t.Run("Purge keeps rows within the retention", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		srv := retainingServer(repos, 30*24*time.Hour)
+		mutate(t, srv, `mutation { deleteProject(id: 2) }`)
+
+		got := firstError(t, srv, admin, `mutation { purgeTrash(olderThanDays: 7) { projects } }`)
+		if got.Extensions["code"] != "VALIDATION_FAILED" {
+			t.Errorf("expected a purge inside the retention to be rejected, got %v %q", got.Extensions["code"], got.Message)
+		}
+
+		data := responseData(t, srv, admin, GraphQLRequest{Query: `mutation { purgeTrash { projects tickets } }`})
+		if purged := data["purgeTrash"].(map[string]interface{}); purged["projects"] != float64(0) || purged["tickets"] != float64(0) {
+			t.Errorf("expected nothing purged yet, got %v", purged)
+		}
+	})
+
+	t.Run("Purge removes expired rows for good", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		srv := presentingServer(repos, true)
+		mutate(t, srv, `mutation { deleteProject(id: 2) }`)
+
+		data := responseData(t, srv, admin, GraphQLRequest{Query: `mutation { purgeTrash(olderThanDays: 0) { projects tickets employees } }`})
+		if purged := data["purgeTrash"].(map[string]interface{}); purged["projects"] != float64(1) || purged["tickets"] != float64(1) || purged["employees"] != float64(0) {
+			t.Errorf("expected the project and its ticket purged, got %v", purged)
+		}
+		if _, err := repos.Projects.GetTrashed(ctx, 2); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("expected the project gone from the trash, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,195 @@
+package tdd
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/JonJenson-MFIn/project-management-system-api/policy"
+	"github.com/JonJenson-MFIn/project-management-system-api/workflow"
+)
+
+func TestWorkflowRules(t *testing.T) {
+	employee := &model.AuthUser{ID: 3, Role: model.RoleEmployee}
+	tl := &model.AuthUser{ID: 4, Role: model.RoleTl}
+
+	tests := []struct {
+		name string
+		user *model.AuthUser
+		from db.StatusDB
+		to   db.StatusDB
+		want error
+	}{
+		{"Work can start", employee, db.StatusNotStartedDB, db.StatusInProgressDB, nil},
+		{"Staying put is always allowed", employee, db.StatusCancelledDB, db.StatusCancelledDB, nil},
+		{"Cancelled work cannot resume directly", employee, db.StatusCancelledDB, db.StatusInProgressDB, workflow.ErrInvalidTransition},
+		{"Reopening cancelled work needs a team leader", employee, db.StatusCancelledDB, db.StatusNotStartedDB, policy.ErrForbidden},
+		{"Team leaders may reopen cancelled work", tl, db.StatusCancelledDB, db.StatusNotStartedDB, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := workflow.Check(&workflow.Default, tt.user, tt.from, tt.to)
+			if tt.want == nil && err != nil || tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	t.Run("CompletedAt follows COMPLETED", func(t *testing.T) {
+		now := time.Now()
+		var completedAt *time.Time
+
+		workflow.Stamp(&completedAt, db.StatusInProgressDB, db.StatusCompletedDB, now)
+		if completedAt == nil || !completedAt.Equal(now) {
+			t.Fatalf("expected completedAt on entering COMPLETED, got %v", completedAt)
+		}
+		workflow.Stamp(&completedAt, db.StatusCompletedDB, db.StatusCompletedDB, now.Add(time.Hour))
+		if !completedAt.Equal(now) {
+			t.Errorf("expected completedAt to stay while COMPLETED, got %v", completedAt)
+		}
+		workflow.Stamp(&completedAt, db.StatusCompletedDB, db.StatusInProgressDB, now)
+		if completedAt != nil {
+			t.Errorf("expected completedAt to clear on leaving COMPLETED, got %v", completedAt)
+		}
+	})
+}
+
+func TestWorkflowValidation(t *testing.T) {
+	todo, doing, done := db.StatusNotStartedDB, db.StatusInProgressDB, db.StatusCompletedDB
+	tests := []struct {
+		name     string
+		workflow db.Workflow
+		valid    bool
+	}{
+		{"Default", workflow.Default, true},
+		{"No states", db.Workflow{}, false},
+		{"State listed twice", db.Workflow{States: []db.StatusDB{todo, todo}}, false},
+		{"Transition to an unknown state", db.Workflow{States: []db.StatusDB{todo}, Transitions: []db.WorkflowTransition{{From: todo, To: done}}}, false},
+		{"Transition to itself", db.Workflow{States: []db.StatusDB{todo}, Transitions: []db.WorkflowTransition{{From: todo, To: todo}}}, false},
+		{"Transition listed twice", db.Workflow{States: []db.StatusDB{todo, doing}, Transitions: []db.WorkflowTransition{{From: todo, To: doing}, {From: todo, To: doing}}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := workflow.Validate(&tt.workflow)
+			if tt.valid != (err == nil) || err != nil && !errors.Is(err, db.ErrInvalidInput) {
+				t.Errorf("expected valid=%v, got %v", tt.valid, err)
+			}
+		})
+	}
+}
+
+func TestWorkflowMutations(t *testing.T) {
+	ctx := context.Background()
+	admin := &model.AuthUser{ID: 1, Role: model.RoleAdmin}
+	engineer := &model.AuthUser{ID: 3, Role: model.RoleEmployee}
+
+	// A project workflow where only managers may sign work off
+	review := GraphQLRequest{Query: `mutation { setWorkflow(projectID: 2, input: {
+		states: [NOT_STARTED, IN_PROGRESS, COMPLETED],
+		transitions: [
+			{ from: NOT_STARTED, to: IN_PROGRESS },
+			{ from: IN_PROGRESS, to: COMPLETED, requiredRole: MANAGER }
+		]
+	}) { isDefault } }`}
+
+	t.Run("Transitions stamp and clear completedAt", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		srv := presentingServer(repos, true)
+
+		for _, query := range []string{
+			`mutation { transitionTicket(id: 6, to: IN_PROGRESS) { status } }`,
+			`mutation { transitionTicket(id: 6, to: COMPLETED) { status } }`,
+		} {
+			if errs := responseErrors(t, srv, admin, GraphQLRequest{Query: query}); len(errs) != 0 {
+				t.Fatalf("unexpected errors: %+v", errs)
+			}
+		}
+		if ticket, _ := repos.Tickets.Get(ctx, 6); ticket.Status != db.StatusCompletedDB || ticket.CompletedAt == nil {
+			t.Fatalf("expected a completed ticket with completedAt, got %+v", ticket)
+		}
+
+		errs := responseErrors(t, srv, admin, GraphQLRequest{Query: `mutation { updateTicket(id: 6, input: { status: IN_PROGRESS }) { id } }`})
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %+v", errs)
+		}
+		if ticket, _ := repos.Tickets.Get(ctx, 6); ticket.CompletedAt != nil {
+			t.Errorf("expected completedAt to clear on reopening, got %v", ticket.CompletedAt)
+		}
+	})
+
+	t.Run("Moves outside the workflow are rejected", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		srv := presentingServer(repos, true)
+
+		if errs := responseErrors(t, srv, admin, GraphQLRequest{Query: `mutation { transitionTicket(id: 6, to: CANCELLED) { status } }`}); len(errs) != 0 {
+			t.Fatalf("unexpected errors: %+v", errs)
+		}
+
+		for _, query := range []string{
+			`mutation { transitionTicket(id: 6, to: IN_PROGRESS) { status } }`,
+			`mutation { updateTicket(id: 6, input: { status: IN_PROGRESS }) { id } }`,
+		} {
+			got := firstError(t, srv, admin, query)
+			if got.Extensions["code"] != "INVALID_TRANSITION" {
+				t.Errorf("expected INVALID_TRANSITION for %s, got %v %q", query, got.Extensions["code"], got.Message)
+			}
+		}
+		if ticket, _ := repos.Tickets.Get(ctx, 6); ticket.Status != db.StatusCancelledDB {
+			t.Errorf("expected the ticket to stay cancelled, got %s", ticket.Status)
+		}
+	})
+
+	t.Run("Project workflows limit moves by role", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		srv := presentingServer(repos, true)
+		if errs := responseErrors(t, srv, admin, review); len(errs) != 0 {
+			t.Fatalf("unexpected errors: %+v", errs)
+		}
+
+		if errs := responseErrors(t, srv, engineer, GraphQLRequest{Query: `mutation { transitionTicket(id: 6, to: IN_PROGRESS) { status } }`}); len(errs) != 0 {
+			t.Fatalf("unexpected errors: %+v", errs)
+		}
+		got := firstError(t, srv, engineer, `mutation { transitionTicket(id: 6, to: COMPLETED) { status } }`)
+		if got.Extensions["code"] != "FORBIDDEN" || got.Message != "forbidden: moving from IN_PROGRESS to COMPLETED requires MANAGER or higher" {
+			t.Errorf("expected FORBIDDEN, got %v %q", got.Extensions["code"], got.Message)
+		}
+
+		got = firstError(t, srv, admin, `mutation { addTicket(input: { title: "Parked", projectID: 2, status: ON_HOLD }) { id } }`)
+		if got.Extensions["code"] != "INVALID_TRANSITION" {
+			t.Errorf("expected new work outside the workflow's states to be rejected, got %v %q", got.Extensions["code"], got.Message)
+		}
+	})
+
+	t.Run("Reset returns the project to the default", func(t *testing.T) {
+		captureLogs(t)
+		repos := seedMemory(t)
+		srv := presentingServer(repos, true)
+		responseErrors(t, srv, admin, review)
+
+		if errs := responseErrors(t, srv, admin, GraphQLRequest{Query: `mutation { resetWorkflow(projectID: 2) { isDefault } }`}); len(errs) != 0 {
+			t.Fatalf("unexpected errors: %+v", errs)
+		}
+		if _, err := repos.Workflows.Get(ctx, 2); err == nil {
+			t.Error("expected the project workflow to be removed")
+		}
+	})
+
+	t.Run("Inconsistent definitions are rejected", func(t *testing.T) {
+		captureLogs(t)
+		got := firstError(t, presentingServer(seedMemory(t), true), admin, `mutation { setWorkflow(projectID: 2, input: {
+			states: [NOT_STARTED],
+			transitions: [{ from: NOT_STARTED, to: COMPLETED }]
+		}) { isDefault } }`)
+		if got.Extensions["code"] != "VALIDATION_FAILED" {
+			t.Errorf("expected VALIDATION_FAILED, got %v %q", got.Extensions["code"], got.Message)
+		}
+	})
+}
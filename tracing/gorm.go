@@ -0,0 +1,74 @@
+package tracing
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const spanKey = "tracing:span"
+
+// GormPlugin starts a client span around every SQL statement GORM runs, as a child of
+// the span in the statement's context. Register it with database.Use.
+type GormPlugin struct{}
+
+var _ gorm.Plugin = GormPlugin{}
+
+func (GormPlugin) Name() string {
+	return "tracing"
+}
+
+func (GormPlugin) Initialize(database *gorm.DB) error {
+	callbacks := database.Callback()
+	for _, err := range []error{
+		callbacks.Create().Before("*").Register("tracing:before_create", startSpan("create")),
+		callbacks.Create().After("*").Register("tracing:after_create", endSpan),
+		callbacks.Query().Before("*").Register("tracing:before_query", startSpan("query")),
+		callbacks.Query().After("*").Register("tracing:after_query", endSpan),
+		callbacks.Update().Before("*").Register("tracing:before_update", startSpan("update")),
+		callbacks.Update().After("*").Register("tracing:after_update", endSpan),
+		callbacks.Delete().Before("*").Register("tracing:before_delete", startSpan("delete")),
+		callbacks.Delete().After("*").Register("tracing:after_delete", endSpan),
+		callbacks.Row().Before("*").Register("tracing:before_row", startSpan("row")),
+		callbacks.Row().After("*").Register("tracing:after_row", endSpan),
+		callbacks.Raw().Before("*").Register("tracing:before_raw", startSpan("raw")),
+		callbacks.Raw().After("*").Register("tracing:after_raw", endSpan),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func startSpan(operation string) func(tx *gorm.DB) {
+	return func(tx *gorm.DB) {
+		_, span := Tracer().Start(tx.Statement.Context, "gorm."+operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemNamePostgreSQL, semconv.DBOperationName(operation)),
+		)
+		tx.InstanceSet(spanKey, span)
+	}
+}
+
+// endSpan records the statement with its placeholders, never the bound values
+func endSpan(tx *gorm.DB) {
+	value, ok := tx.InstanceGet(spanKey)
+	if !ok {
+		return
+	}
+	span := value.(trace.Span)
+	defer span.End()
+
+	span.SetAttributes(semconv.DBQueryText(tx.Statement.SQL.String()))
+	if tx.Statement.Table != "" {
+		span.SetAttributes(semconv.DBCollectionName(tx.Statement.Table))
+	}
+	if tx.Error != nil && !errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(tx.Error)
+		span.SetStatus(codes.Error, tx.Error.Error())
+	}
+}
@@ -0,0 +1,85 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Extension starts a span per GraphQL operation and a child span per resolver
+// invocation. Add it to the server with Use.
+type Extension struct{}
+
+var (
+	_ graphql.HandlerExtension    = Extension{}
+	_ graphql.ResponseInterceptor = Extension{}
+	_ graphql.FieldInterceptor    = Extension{}
+)
+
+func (Extension) ExtensionName() string {
+	return "Tracing"
+}
+
+func (Extension) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptResponse wraps execution of an operation; requests that fail to parse or
+// validate have no operation and are left to the HTTP span
+func (Extension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) || graphql.GetOperationContext(ctx).Operation == nil {
+		return next(ctx)
+	}
+
+	rc := graphql.GetOperationContext(ctx)
+	kind := string(rc.Operation.Operation)
+	name := rc.OperationName
+	if name == "" {
+		name = rc.Operation.Name
+	}
+
+	spanName := kind
+	if name != "" {
+		spanName += " " + name
+	}
+	ctx, span := Tracer().Start(ctx, spanName, trace.WithAttributes(
+		attribute.String("graphql.operation.type", kind),
+		attribute.String("graphql.operation.name", name),
+	))
+	defer span.End()
+
+	resp := next(ctx)
+	if resp != nil && len(resp.Errors) > 0 {
+		messages := make([]string, len(resp.Errors))
+		for i, err := range resp.Errors {
+			messages[i] = err.Message
+		}
+		span.SetStatus(codes.Error, strings.Join(messages, "; "))
+	}
+	return resp
+}
+
+// InterceptField traces fields backed by a resolver; plain struct fields would only add
+// noise
+func (Extension) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	ctx, span := Tracer().Start(ctx, fc.Object+"."+fc.Field.Name, trace.WithAttributes(
+		attribute.String("graphql.field.path", fc.Path().String()),
+	))
+	defer span.End()
+
+	res, err := next(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return res, err
+}
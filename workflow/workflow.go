@@ -0,0 +1,106 @@
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/JonJenson-MFIn/project-management-system-api/db"
+	"github.com/JonJenson-MFIn/project-management-system-api/graph/model"
+	"github.com/JonJenson-MFIn/project-management-system-api/policy"
+)
+
+// ErrInvalidTransition is returned when a workflow has no transition between two statuses
+var ErrInvalidTransition = errors.New("invalid status transition")
+
+var tl = db.RoleTlDB
+
+// Default is the workflow of projects that have not defined their own, and of tasks
+// outside any project. Work can be put on hold and resumed, and reopened once completed;
+// bringing back cancelled work is up to a team leader.
+var Default = db.Workflow{
+	States: []db.StatusDB{db.StatusNotStartedDB, db.StatusInProgressDB, db.StatusOnHoldDB, db.StatusCompletedDB, db.StatusCancelledDB},
+	Transitions: []db.WorkflowTransition{
+		{From: db.StatusNotStartedDB, To: db.StatusInProgressDB},
+		{From: db.StatusNotStartedDB, To: db.StatusOnHoldDB},
+		{From: db.StatusNotStartedDB, To: db.StatusCompletedDB},
+		{From: db.StatusNotStartedDB, To: db.StatusCancelledDB},
+		{From: db.StatusInProgressDB, To: db.StatusNotStartedDB},
+		{From: db.StatusInProgressDB, To: db.StatusOnHoldDB},
+		{From: db.StatusInProgressDB, To: db.StatusCompletedDB},
+		{From: db.StatusInProgressDB, To: db.StatusCancelledDB},
+		{From: db.StatusOnHoldDB, To: db.StatusInProgressDB},
+		{From: db.StatusOnHoldDB, To: db.StatusCancelledDB},
+		{From: db.StatusCompletedDB, To: db.StatusInProgressDB},
+		{From: db.StatusCancelledDB, To: db.StatusNotStartedDB, RequiredRole: &tl},
+	},
+}
+
+// Validate checks that a definition only moves between its own states, and names each
+// state and transition once
+func Validate(w *db.Workflow) error {
+	if len(w.States) == 0 {
+		return db.InvalidInput("a workflow needs at least one state")
+	}
+	for i, state := range w.States {
+		if slices.Contains(w.States[:i], state) {
+			return db.InvalidInput("state %s is listed twice", state)
+		}
+	}
+
+	for i, t := range w.Transitions {
+		for _, state := range []db.StatusDB{t.From, t.To} {
+			if !slices.Contains(w.States, state) {
+				return db.InvalidInput("transition %s -> %s uses %s, which is not a state of the workflow", t.From, t.To, state)
+			}
+		}
+		if t.From == t.To {
+			return db.InvalidInput("transition %s -> %s does not change the status", t.From, t.To)
+		}
+		for _, other := range w.Transitions[:i] {
+			if other.From == t.From && other.To == t.To {
+				return db.InvalidInput("transition %s -> %s is listed twice", t.From, t.To)
+			}
+		}
+	}
+	return nil
+}
+
+// CheckState checks that work may be in status under w, as when it is created or moved
+// to another project
+func CheckState(w *db.Workflow, status db.StatusDB) error {
+	if !slices.Contains(w.States, status) {
+		return fmt.Errorf("%w: %s is not a state of the workflow", ErrInvalidTransition, status)
+	}
+	return nil
+}
+
+// Check checks that user may move work from one status to another under w. Staying in
+// the same status is always allowed.
+func Check(w *db.Workflow, user *model.AuthUser, from, to db.StatusDB) error {
+	if from == to {
+		return nil
+	}
+	for _, t := range w.Transitions {
+		if t.From != from || t.To != to {
+			continue
+		}
+		if t.RequiredRole != nil && (user == nil || !user.Role.AtLeast(db.RoleToModel(*t.RequiredRole))) {
+			return fmt.Errorf("%w: moving from %s to %s requires %s or higher", policy.ErrForbidden, from, to, *t.RequiredRole)
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: cannot move from %s to %s", ErrInvalidTransition, from, to)
+}
+
+// Stamp keeps completedAt in step with the status: it is set on entering COMPLETED and
+// cleared on leaving it
+func Stamp(completedAt **time.Time, from, to db.StatusDB, now time.Time) {
+	switch {
+	case to == db.StatusCompletedDB && from != db.StatusCompletedDB:
+		*completedAt = &now
+	case to != db.StatusCompletedDB:
+		*completedAt = nil
+	}
+}